@@ -6,8 +6,10 @@ import (
 	"encoding/hex"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/babylonlabs-io/covenant-signer/config"
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/btcutil"
@@ -50,6 +52,10 @@ func nofitierStateToClientState(state notifier.TxConfStatus) TxStatus {
 
 type BtcClient struct {
 	RpcClient *rpcclient.Client
+	// connection labels metrics of RPC calls, which are recorded only if
+	// metrics are set
+	connection string
+	metrics    *m.CovenantSignerMetrics
 }
 
 func btcConfigToConnConfig(cfg *config.ParsedBtcConfig) *rpcclient.ConnConfig {
@@ -76,7 +82,10 @@ func NewBtcClient(cfg *config.ParsedBtcConfig) (*BtcClient, error) {
 }
 
 func (c *BtcClient) SendTx(tx *wire.MsgTx) (*chainhash.Hash, error) {
-	return c.RpcClient.SendRawTransaction(tx, true)
+	start := time.Now()
+	txHash, err := c.RpcClient.SendRawTransaction(tx, true)
+	c.observe("sendrawtransaction", start, err)
+	return txHash, err
 }
 
 // Helpers to easily build transactions
@@ -200,11 +209,16 @@ func buildTxFromOutputs(
 }
 
 func (w *BtcClient) UnlockWallet(timoutSec int64, passphrase string) error {
-	return w.RpcClient.WalletPassphrase(passphrase, timoutSec)
+	start := time.Now()
+	err := w.RpcClient.WalletPassphrase(passphrase, timoutSec)
+	w.observe("walletpassphrase", start, err)
+	return err
 }
 
 func (w *BtcClient) DumpPrivateKey(address btcutil.Address) (*btcec.PrivateKey, error) {
+	start := time.Now()
 	privKey, err := w.RpcClient.DumpPrivKey(address)
+	w.observe("dumpprivkey", start, err)
 
 	if err != nil {
 		return nil, err
@@ -218,7 +232,7 @@ func (w *BtcClient) CreateTransaction(
 	feeRatePerKb btcutil.Amount,
 	changeAddres btcutil.Address) (*wire.MsgTx, error) {
 
-	utxoResults, err := w.RpcClient.ListUnspent()
+	utxoResults, err := w.listUnspent()
 
 	if err != nil {
 		return nil, err
@@ -276,11 +290,21 @@ func (w *BtcClient) CreateAndSignTx(
 }
 
 func (w *BtcClient) SignRawTransaction(tx *wire.MsgTx) (*wire.MsgTx, bool, error) {
-	return w.RpcClient.SignRawTransactionWithWallet(tx)
+	start := time.Now()
+	signedTx, signed, err := w.RpcClient.SignRawTransactionWithWallet(tx)
+	w.observe("signrawtransactionwithwallet", start, err)
+	return signedTx, signed, err
+}
+
+func (w *BtcClient) listUnspent() ([]btcjson.ListUnspentResult, error) {
+	start := time.Now()
+	results, err := w.RpcClient.ListUnspent()
+	w.observe("listunspent", start, err)
+	return results, err
 }
 
 func (w *BtcClient) ListOutputs(onlySpendable bool) ([]Utxo, error) {
-	utxoResults, err := w.RpcClient.ListUnspent()
+	utxoResults, err := w.listUnspent()
 
 	if err != nil {
 		return nil, err
@@ -302,7 +326,7 @@ func (w *BtcClient) TxDetails(txHash *chainhash.Hash, pkScript []byte) (*notifie
 		return nil, TxNotFound, err
 	}
 
-	res, state, err := notifier.ConfDetailsFromTxIndex(w.RpcClient, req, txNotFoundErrMsgBitcoind)
+	res, state, err := notifier.ConfDetailsFromTxIndex(txIndexConn{c: w}, req, txNotFoundErrMsgBitcoind)
 
 	if err != nil {
 		return nil, TxNotFound, err
@@ -319,6 +343,7 @@ func (w *BtcClient) SignPsbt(packet *psbt.Packet) (*psbt.Packet, error) {
 	}
 
 	sign := true
+	start := time.Now()
 	result, err := w.RpcClient.WalletProcessPsbt(
 		psbtEncoded,
 		&sign,
@@ -326,6 +351,7 @@ func (w *BtcClient) SignPsbt(packet *psbt.Packet) (*psbt.Packet, error) {
 		"DEFAULT",
 		nil,
 	)
+	w.observe("walletprocesspsbt", start, err)
 
 	if err != nil {
 		return nil, err
@@ -347,7 +373,9 @@ func (w *BtcClient) SignPsbt(packet *psbt.Packet) (*psbt.Packet, error) {
 }
 
 func (w *BtcClient) BestBlockHeight() (uint32, error) {
+	start := time.Now()
 	count, err := w.RpcClient.GetBlockCount()
+	w.observe("getblockcount", start, err)
 
 	if err != nil {
 		return 0, err
@@ -355,3 +383,40 @@ func (w *BtcClient) BestBlockHeight() (uint32, error) {
 	//#nosec G115 -- safe conversion, nubmer of blocks is always positive and less than math.MaxUint32
 	return uint32(count), nil
 }
+
+func (w *BtcClient) BlockHashAtHeight(height uint32) (*chainhash.Hash, error) {
+	start := time.Now()
+	blockHash, err := w.RpcClient.GetBlockHash(int64(height))
+	w.observe("getblockhash", start, err)
+	return blockHash, err
+}
+
+// UnspentOutput returns output of transaction included in the chain, or nil if
+// the output does not exist or is already spent
+func (w *BtcClient) UnspentOutput(outpoint *wire.OutPoint) (*wire.TxOut, error) {
+	start := time.Now()
+	res, err := w.RpcClient.GetTxOut(&outpoint.Hash, outpoint.Index, false)
+	w.observe("gettxout", start, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, nil
+	}
+
+	pkScript, err := hex.DecodeString(res.ScriptPubKey.Hex)
+
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := btcutil.NewAmount(res.Value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.NewTxOut(int64(value), pkScript), nil
+}
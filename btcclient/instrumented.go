@@ -0,0 +1,107 @@
+package btcclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/babylonlabs-io/covenant-signer/config"
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// Connection labels of bitcoind RPC metrics
+const (
+	ConnectionFullNode = "full_node"
+	ConnectionSigner   = "signer"
+)
+
+// NewInstrumentedBtcClient returns client recording count, errors and
+// duration of RPC calls in metrics, labeled by the connection
+func NewInstrumentedBtcClient(
+	cfg *config.ParsedBtcConfig,
+	connection string,
+	metrics *m.CovenantSignerMetrics,
+) (*BtcClient, error) {
+	c, err := NewBtcClient(cfg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.connection = connection
+	c.metrics = metrics
+
+	return c, nil
+}
+
+// observe records RPC call which started at start and finished with err
+func (c *BtcClient) observe(method string, start time.Time, err error) {
+	if c.metrics == nil {
+		return
+	}
+
+	c.metrics.ObserveBtcRpcCall(c.connection, method, time.Since(start), err != nil)
+}
+
+// txIndexConn records RPC calls made by transaction index lookups
+type txIndexConn struct {
+	c *BtcClient
+}
+
+func (i txIndexConn) GetRawTransactionVerbose(txHash *chainhash.Hash) (*btcjson.TxRawResult, error) {
+	start := time.Now()
+	res, err := i.c.RpcClient.GetRawTransactionVerbose(txHash)
+	i.c.observe("getrawtransaction", start, err)
+	return res, err
+}
+
+func (i txIndexConn) GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error) {
+	start := time.Now()
+	res, err := i.c.RpcClient.GetBlock(blockHash)
+	i.c.observe("getblock", start, err)
+	return res, err
+}
+
+// Ping checks that bitcoind responds. It is not counted as RPC call, so that
+// health checks do not skew the latency of real calls.
+func (c *BtcClient) Ping() error {
+	_, err := c.RpcClient.GetBlockCount()
+	return err
+}
+
+// probe checks connectivity of the client and records the result
+func (c *BtcClient) probe() {
+	start := time.Now()
+	err := c.Ping()
+	c.metrics.SetBtcRpcProbe(c.connection, err == nil, time.Since(start))
+
+	if err != nil {
+		logging.Logger(logging.ComponentBtc).Warn().Err(err).Str("connection", c.connection).Msg("bitcoind connectivity probe failed")
+	}
+}
+
+// StartProbe periodically probes connectivity of the client until the context
+// is done. It does nothing if the client is not instrumented.
+func (c *BtcClient) StartProbe(ctx context.Context, interval time.Duration) {
+	if c.metrics == nil || interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		c.probe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.probe()
+			}
+		}
+	}()
+}
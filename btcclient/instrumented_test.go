@@ -0,0 +1,95 @@
+package btcclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/babylonlabs-io/covenant-signer/btcclient"
+	"github.com/babylonlabs-io/covenant-signer/config"
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeBitcoind returns server answering getblockcount and failing all other
+// RPC methods. All methods fail once failing is set.
+func newFakeBitcoind(t *testing.T, failing *atomic.Bool) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Id     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		resp := map[string]any{"id": req.Id, "result": nil, "error": nil}
+		if req.Method == "getblockcount" && !failing.Load() {
+			resp["result"] = 100
+		} else {
+			resp["error"] = map[string]any{"code": -32601, "message": "Method not found"}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestInstrumentedBtcClientRecordsRpcCalls(t *testing.T) {
+	srv := newFakeBitcoind(t, &atomic.Bool{})
+	metrics := m.NewCovenantSignerMetrics()
+
+	client, err := btcclient.NewInstrumentedBtcClient(
+		&config.ParsedBtcConfig{Host: strings.TrimPrefix(srv.URL, "http://"), User: "user", Pass: "pass"},
+		btcclient.ConnectionFullNode,
+		metrics,
+	)
+	require.NoError(t, err)
+
+	height, err := client.BestBlockHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint32(100), height)
+
+	_, err = client.BlockHashAtHeight(100)
+	require.Error(t, err)
+
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.BtcRpcCalls.WithLabelValues("full_node", "getblockcount")))
+	require.Equal(t, 0.0, testutil.ToFloat64(metrics.BtcRpcErrors.WithLabelValues("full_node", "getblockcount")))
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.BtcRpcCalls.WithLabelValues("full_node", "getblockhash")))
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.BtcRpcErrors.WithLabelValues("full_node", "getblockhash")))
+	require.Equal(t, 2, testutil.CollectAndCount(metrics.BtcRpcDuration))
+}
+
+func TestBtcClientProbe(t *testing.T) {
+	var failing atomic.Bool
+	srv := newFakeBitcoind(t, &failing)
+	metrics := m.NewCovenantSignerMetrics()
+
+	client, err := btcclient.NewInstrumentedBtcClient(
+		&config.ParsedBtcConfig{Host: strings.TrimPrefix(srv.URL, "http://"), User: "user", Pass: "pass"},
+		btcclient.ConnectionSigner,
+		metrics,
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client.StartProbe(ctx, 10*time.Millisecond)
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(metrics.BtcRpcUp.WithLabelValues("signer")) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// probes are not counted as RPC calls
+	require.Equal(t, 0, testutil.CollectAndCount(metrics.BtcRpcCalls))
+
+	failing.Store(true)
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(metrics.BtcRpcUp.WithLabelValues("signer")) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
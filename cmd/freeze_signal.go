@@ -0,0 +1,36 @@
+//go:build !windows
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+)
+
+// freezeOnSignal freezes signing whenever SIGUSR1 is received, until the
+// context is done. The signal never unfreezes signing, so that sending it
+// twice cannot resume signing by accident.
+func freezeOnSignal(ctx context.Context, freeze *signerapp.Freeze) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGUSR1)
+
+	go func() {
+		defer signal.Stop(signals)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-signals:
+				if err := freeze.Freeze("SIGUSR1"); err != nil {
+					logging.Logger(logging.ComponentServer).Error().Err(err).Msg("failed to persist signing freeze")
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,13 @@
+//go:build windows
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+)
+
+// freezeOnSignal does nothing, as SIGUSR1 does not exist on Windows. Signing
+// can still be frozen with the sentinel file or the admin server.
+func freezeOnSignal(_ context.Context, _ *signerapp.Freeze) {}
@@ -1,21 +1,77 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"path/filepath"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/babylonlabs-io/covenant-signer/btcclient"
 	"github.com/babylonlabs-io/covenant-signer/config"
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
 	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/observability/tracing"
 	"github.com/babylonlabs-io/covenant-signer/signerapp"
 	"github.com/babylonlabs-io/covenant-signer/signerservice"
 )
 
+const tracingShutdownTimeout = 5 * time.Second
+
 func init() {
 	rootCmd.AddCommand(runSignerCmd)
 }
 
+func newParamsRetriever(
+	ctx context.Context,
+	configPath string,
+	parsedConfig *config.ParsedConfig,
+	metrics *m.CovenantSignerMetrics,
+) (signerapp.ReloadableParams, error) {
+	cfg := parsedConfig.ParamsConfig
+	validator := signerapp.NewGlobalParamsValidator(
+		parsedConfig.BtcNodeConfig.Network,
+		parsedConfig.SignerAppConfig.MaxStakingTransactionHeight,
+	)
+
+	switch cfg.Source {
+	case config.ParamsSourceFile:
+		r, err := signerapp.NewReloadableParamsRetriever(globalParamPath, validator, metrics)
+		if err != nil {
+			return nil, err
+		}
+		return r, r.Start(ctx)
+	case config.ParamsSourceRemote:
+		// verified remote params are cached in separate file, so that the
+		// operator's global params file is never overwritten
+		cachePath := configRelativePath(configPath, cfg.CacheFile)
+		r, err := signerapp.NewRemoteParamsRetriever(ctx, cfg, nil, cachePath, validator, metrics)
+		if err != nil {
+			return nil, err
+		}
+		return r, r.Start(ctx)
+	case config.ParamsSourceBabylon:
+		r, err := signerapp.NewBabylonNodeParamsRetriever(ctx, cfg, nil, validator, metrics)
+		if err != nil {
+			return nil, err
+		}
+		return r, r.Start(ctx)
+	default:
+		return nil, fmt.Errorf("unknown params source %s", cfg.Source)
+	}
+}
+
+// configRelativePath resolves relative path against directory of the config
+// file
+func configRelativePath(configPath string, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(filepath.Dir(configPath), path)
+}
+
 var runSignerCmd = &cobra.Command{
 	Use:   "start",
 	Short: "starts the signer service",
@@ -35,38 +91,103 @@ var runSignerCmd = &cobra.Command{
 			return err
 		}
 
-		parsedGlobalParams, err := signerapp.NewVersionedParamsRetriever(globalParamPath)
+		closeLogs, err := logging.Init(parsedConfig.LoggingConfig)
 
 		if err != nil {
 			return err
 		}
 
-		fullNodeClient, err := btcclient.NewBtcClient(parsedConfig.BtcNodeConfig)
+		defer func() { _ = closeLogs() }()
+
+		shutdownTracing, err := tracing.Init(cmd.Context(), parsedConfig.TracingConfig)
+
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			// flush spans which were not exported yet
+			ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+			defer cancel()
+			_ = shutdownTracing(ctx)
+		}()
+
+		metrics := m.NewCovenantSignerMetrics()
+
+		parsedGlobalParams, err := newParamsRetriever(cmd.Context(), configPath, parsedConfig, metrics)
+
+		if err != nil {
+			return err
+		}
+
+		fullNodeClient, err := btcclient.NewInstrumentedBtcClient(
+			parsedConfig.BtcNodeConfig,
+			btcclient.ConnectionFullNode,
+			metrics,
+		)
 
 		if err != nil {
 			return err
 		}
 
+		fullNodeClient.StartProbe(cmd.Context(), parsedConfig.MetricsConfig.BtcProbeInterval)
+
 		chainInfo := signerapp.NewBitcoindChainInfo(fullNodeClient)
 
-		signerClient, err := btcclient.NewBtcClient(parsedConfig.BtcSignerConfig)
+		signerClient, err := btcclient.NewInstrumentedBtcClient(
+			parsedConfig.BtcSignerConfig,
+			btcclient.ConnectionSigner,
+			metrics,
+		)
 
 		if err != nil {
 			return err
 		}
 
+		signerClient.StartProbe(cmd.Context(), parsedConfig.MetricsConfig.BtcProbeInterval)
+
 		// TODO: Add options to use customn remote signers
-		signer := signerapp.NewPsbtSigner(signerClient)
+		var signer signerapp.ExternalBtcSigner
+		switch parsedConfig.SignerAppConfig.SignerType {
+		case config.SignerTypePrivKey:
+			signer = signerapp.NewPrivKeySigner(signerClient)
+		default:
+			signer = signerapp.NewPsbtSigner(signerClient)
+		}
 
 		app := signerapp.NewSignerApp(
-			signer,
-			chainInfo,
-			parsedGlobalParams,
+			signerapp.NewInstrumentedBtcSigner(signer, metrics),
+			signerapp.NewInstrumentedBtcChainInfo(chainInfo, metrics),
+			signerapp.NewInstrumentedParamsRetriever(parsedGlobalParams, metrics),
 			parsedConfig.SignerAppConfig,
 			parsedConfig.BtcNodeConfig.Network,
 		)
 
-		metrics := m.NewCovenantSignerMetrics()
+		freeze, err := signerapp.NewFreeze(configRelativePath(configPath, parsedConfig.SignerAppConfig.FreezeFile), metrics)
+
+		if err != nil {
+			return err
+		}
+
+		if err := freeze.Start(cmd.Context()); err != nil {
+			return err
+		}
+
+		freezeOnSignal(cmd.Context(), freeze)
+		app.SetFreeze(freeze)
+
+		if parsedConfig.SignerAppConfig.PolicyFile != "" {
+			policy, err := signerapp.LoadPolicy(
+				configRelativePath(configPath, parsedConfig.SignerAppConfig.PolicyFile),
+				time.Now,
+			)
+
+			if err != nil {
+				return err
+			}
+
+			app.SetPolicy(policy)
+		}
 
 		srv, err := signerservice.New(
 			cmd.Context(),
@@ -79,11 +200,37 @@ var runSignerCmd = &cobra.Command{
 			return err
 		}
 
+		var grpcSrv *signerservice.GrpcServer
+		if parsedConfig.ServerConfig.GrpcPort != 0 {
+			grpcSrv = signerservice.NewGrpcServer(parsedConfig, srv)
+		}
+
 		metricsAddress := fmt.Sprintf("%s:%d", cfg.Metrics.Host, cfg.Metrics.Port)
 
 		m.Start(metricsAddress, metrics.Registry)
 
+		var adminSrv *signerservice.AdminServer
+		if parsedConfig.AdminConfig.Enabled() {
+			adminSrv = signerservice.NewAdminServer(cfg, parsedConfig, srv, parsedGlobalParams, freeze, map[string]signerservice.Pinger{
+				btcclient.ConnectionFullNode: fullNodeClient,
+				btcclient.ConnectionSigner:   signerClient,
+			})
+		}
+
 		// TODO: Add signal handling and gracefull shutdown
-		return srv.Start()
+		if grpcSrv == nil && adminSrv == nil {
+			return srv.Start()
+		}
+
+		// exit as soon as any of the servers fails
+		errs := make(chan error, 3)
+		go func() { errs <- srv.Start() }()
+		if grpcSrv != nil {
+			go func() { errs <- grpcSrv.Start() }()
+		}
+		if adminSrv != nil {
+			go func() { errs <- adminSrv.Start() }()
+		}
+		return <-errs
 	},
 }
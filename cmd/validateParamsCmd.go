@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/babylonlabs-io/covenant-signer/config"
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(validateParamsCmd)
+}
+
+var validateParamsCmd = &cobra.Command{
+	Use:   "validate-params",
+	Short: "validates global params file against the configuration, without starting the service",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configPath, err := cmd.Flags().GetString(configPathKey)
+		if err != nil {
+			return err
+		}
+
+		cfg, err := config.GetConfig(configPath)
+		if err != nil {
+			return err
+		}
+
+		parsedConfig, err := cfg.Parse()
+		if err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(globalParamPath)
+		if err != nil {
+			return err
+		}
+
+		validator := signerapp.NewGlobalParamsValidator(
+			parsedConfig.BtcNodeConfig.Network,
+			parsedConfig.SignerAppConfig.MaxStakingTransactionHeight,
+		)
+
+		parsedParams, err := validator.Validate(data)
+		if err != nil {
+			return err
+		}
+
+		latest := parsedParams.Versions[len(parsedParams.Versions)-1]
+		fmt.Printf(
+			"Global params file %s is valid. Versions: %d, latest version: %d activated at height %d \n",
+			globalParamPath,
+			len(parsedParams.Versions),
+			latest.Version,
+			latest.ActivationHeight,
+		)
+		return nil
+	},
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/netip"
+)
+
+// AdminConfig defines admin server used to inspect and control the signer at
+// runtime. It is only reachable from localhost, with the admin token.
+type AdminConfig struct {
+	// Host is loopback address the admin server listens on
+	Host string `mapstructure:"host"`
+	// Port of the admin server, 0 disables the admin server
+	Port int `mapstructure:"port"`
+	// TokenHash is hex encoded sha256 hash of the token sent in Authorization
+	// header of admin requests
+	TokenHash string `mapstructure:"token-hash"`
+}
+
+type ParsedAdminConfig struct {
+	Host      string
+	Port      int
+	TokenHash [sha256.Size]byte
+}
+
+// Enabled returns true if the admin server should be started
+func (c *ParsedAdminConfig) Enabled() bool {
+	return c.Port != 0
+}
+
+func (c *AdminConfig) Parse() (*ParsedAdminConfig, error) {
+	if c.Port == 0 {
+		return &ParsedAdminConfig{Host: c.Host}, nil
+	}
+
+	if c.Port < 1024 || c.Port > 65535 {
+		return nil, fmt.Errorf("admin server port must be 0 or between 1024 and 65535 (inclusive)")
+	}
+
+	addr, err := netip.ParseAddr(c.Host)
+	if err != nil || !addr.IsLoopback() {
+		return nil, fmt.Errorf("admin server host must be loopback address, got %q", c.Host)
+	}
+
+	// other local processes and browsers can reach loopback address as well,
+	// so admin requests must be authenticated
+	tokenHash, err := hex.DecodeString(c.TokenHash)
+	if err != nil || len(tokenHash) != sha256.Size {
+		return nil, fmt.Errorf("admin token hash must be hex encoded sha256 hash of the admin token")
+	}
+
+	return &ParsedAdminConfig{
+		Host:      c.Host,
+		Port:      c.Port,
+		TokenHash: [sha256.Size]byte(tokenHash),
+	}, nil
+}
+
+func DefaultAdminConfig() *AdminConfig {
+	return &AdminConfig{
+		Host:      "127.0.0.1",
+		Port:      0,
+		TokenHash: "",
+	}
+}
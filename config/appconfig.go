@@ -5,12 +5,37 @@ import (
 	"math"
 )
 
+type SignerType string
+
+const (
+	// SignerTypePsbt signs transactions by sending psbt packets to bitcoind wallet
+	SignerTypePsbt SignerType = "psbt"
+	// SignerTypePrivKey signs transactions with private key dumped from bitcoind
+	// wallet. It is required to create adaptor signatures over slashing transactions.
+	SignerTypePrivKey SignerType = "privkey"
+)
+
 type SignerAppConfig struct {
-	MaxStakingTransactionHeight int `mapstructure:"max-staking-transaction-height"`
+	MaxStakingTransactionHeight int    `mapstructure:"max-staking-transaction-height"`
+	SignerType                  string `mapstructure:"signer-type"`
+	MaxBatchSize                int    `mapstructure:"max-batch-size"`
+	BatchConcurrency            int    `mapstructure:"batch-concurrency"`
+	// FreezeFile is sentinel file which freezes signing while it exists.
+	// Relative path is resolved against directory of the config file.
+	FreezeFile string `mapstructure:"freeze-file"`
+	// PolicyFile defines additional rules of unbonding signing, no policy is
+	// applied if empty. Relative path is resolved against directory of the
+	// config file.
+	PolicyFile string `mapstructure:"policy-file"`
 }
 
 type ParsedSignerAppConfig struct {
 	MaxStakingTransactionHeight uint32
+	SignerType                  SignerType
+	MaxBatchSize                int
+	BatchConcurrency            int
+	FreezeFile                  string
+	PolicyFile                  string
 }
 
 func (c *SignerAppConfig) Parse() (*ParsedSignerAppConfig, error) {
@@ -22,13 +47,40 @@ func (c *SignerAppConfig) Parse() (*ParsedSignerAppConfig, error) {
 		return nil, fmt.Errorf("max staking transaction height is too large. Max value is %d", math.MaxUint32)
 	}
 
+	signerType := SignerType(c.SignerType)
+	if signerType != SignerTypePsbt && signerType != SignerTypePrivKey {
+		return nil, fmt.Errorf("invalid signer type %s. Allowed values: %s, %s", c.SignerType, SignerTypePsbt, SignerTypePrivKey)
+	}
+
+	if c.MaxBatchSize <= 0 {
+		return nil, fmt.Errorf("max batch size must be positive")
+	}
+
+	if c.BatchConcurrency <= 0 {
+		return nil, fmt.Errorf("batch concurrency must be positive")
+	}
+
+	if c.FreezeFile == "" {
+		return nil, fmt.Errorf("freeze file must not be empty")
+	}
+
 	return &ParsedSignerAppConfig{
 		MaxStakingTransactionHeight: uint32(c.MaxStakingTransactionHeight),
+		SignerType:                  signerType,
+		MaxBatchSize:                c.MaxBatchSize,
+		BatchConcurrency:            c.BatchConcurrency,
+		FreezeFile:                  c.FreezeFile,
+		PolicyFile:                  c.PolicyFile,
 	}, nil
 }
 
 func DefaultSignerAppConfig() *SignerAppConfig {
 	return &SignerAppConfig{
 		MaxStakingTransactionHeight: math.MaxUint32,
+		SignerType:                  string(SignerTypePsbt),
+		MaxBatchSize:                100,
+		BatchConcurrency:            10,
+		FreezeFile:                  "signing.frozen",
+		PolicyFile:                  "",
 	}
 }
@@ -0,0 +1,154 @@
+package config
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AuthConfig defines how clients of the signing server are authenticated.
+// Each key entry has format <client-id>:<hex value>. If no keys are configured,
+// requests are not authenticated.
+type AuthConfig struct {
+	// ApiKeys are sha256 hashes of static api keys
+	ApiKeys []string `mapstructure:"api-keys"`
+	// HmacKeys are secrets used to verify HMAC-SHA256 signed requests
+	HmacKeys []string `mapstructure:"hmac-keys"`
+	// Ed25519Keys are public keys used to verify Ed25519 signed requests
+	Ed25519Keys []string `mapstructure:"ed25519-keys"`
+	// MaxClockSkew is max difference in seconds between the timestamp of
+	// signed request and the signer clock
+	MaxClockSkew uint32 `mapstructure:"max-clock-skew"`
+}
+
+type ParsedAuthConfig struct {
+	// ApiKeyHashes maps sha256 hash of api key to client id
+	ApiKeyHashes map[[sha256.Size]byte]string
+	// HmacKeys maps client id to HMAC secret
+	HmacKeys map[string][]byte
+	// Ed25519Keys maps client id to Ed25519 public key
+	Ed25519Keys  map[string]ed25519.PublicKey
+	MaxClockSkew time.Duration
+}
+
+// Enabled returns true if at least one client key is configured
+func (c *ParsedAuthConfig) Enabled() bool {
+	return len(c.ApiKeyHashes) > 0 || len(c.HmacKeys) > 0 || len(c.Ed25519Keys) > 0
+}
+
+func parseClientKey(entry string) (string, []byte, error) {
+	clientId, keyHex, found := strings.Cut(entry, ":")
+
+	if !found || clientId == "" {
+		return "", nil, fmt.Errorf("invalid client key %q, expected <client-id>:<hex value>", entry)
+	}
+
+	key, err := hex.DecodeString(keyHex)
+
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid client key of client %s: %w", clientId, err)
+	}
+
+	return clientId, key, nil
+}
+
+func (c *AuthConfig) Parse() (*ParsedAuthConfig, error) {
+	parsed := &ParsedAuthConfig{
+		ApiKeyHashes: make(map[[sha256.Size]byte]string),
+		HmacKeys:     make(map[string][]byte),
+		Ed25519Keys:  make(map[string]ed25519.PublicKey),
+		MaxClockSkew: time.Duration(c.MaxClockSkew) * time.Second,
+	}
+
+	// client id identifies client in logs and metrics, so it must be unique
+	// across all key types
+	clientIds := make(map[string]struct{})
+	addClient := func(clientId string) error {
+		if _, ok := clientIds[clientId]; ok {
+			return fmt.Errorf("duplicated auth client id %s", clientId)
+		}
+		clientIds[clientId] = struct{}{}
+		return nil
+	}
+
+	for _, entry := range c.ApiKeys {
+		clientId, hash, err := parseClientKey(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(hash) != sha256.Size {
+			return nil, fmt.Errorf("api key hash of client %s must be %d bytes", clientId, sha256.Size)
+		}
+
+		if err := addClient(clientId); err != nil {
+			return nil, err
+		}
+
+		// api key identifies the client, shared key would authenticate all
+		// its clients as the same one
+		if otherClientId, ok := parsed.ApiKeyHashes[[sha256.Size]byte(hash)]; ok {
+			return nil, fmt.Errorf("api key hash of client %s is duplicated by client %s", otherClientId, clientId)
+		}
+
+		parsed.ApiKeyHashes[[sha256.Size]byte(hash)] = clientId
+	}
+
+	for _, entry := range c.HmacKeys {
+		clientId, secret, err := parseClientKey(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(secret) < 32 {
+			return nil, fmt.Errorf("hmac secret of client %s must be at least 32 bytes", clientId)
+		}
+
+		if err := addClient(clientId); err != nil {
+			return nil, err
+		}
+
+		parsed.HmacKeys[clientId] = secret
+	}
+
+	for _, entry := range c.Ed25519Keys {
+		clientId, pubKey, err := parseClientKey(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(pubKey) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("ed25519 public key of client %s must be %d bytes", clientId, ed25519.PublicKeySize)
+		}
+
+		if err := addClient(clientId); err != nil {
+			return nil, err
+		}
+
+		for otherClientId, otherPubKey := range parsed.Ed25519Keys {
+			if otherPubKey.Equal(ed25519.PublicKey(pubKey)) {
+				return nil, fmt.Errorf("ed25519 public key of client %s is duplicated by client %s", otherClientId, clientId)
+			}
+		}
+
+		parsed.Ed25519Keys[clientId] = ed25519.PublicKey(pubKey)
+	}
+
+	if (len(parsed.HmacKeys) > 0 || len(parsed.Ed25519Keys) > 0) && parsed.MaxClockSkew == 0 {
+		return nil, fmt.Errorf("max clock skew must be positive if signed requests are enabled")
+	}
+
+	return parsed, nil
+}
+
+func DefaultAuthConfig() *AuthConfig {
+	return &AuthConfig{
+		ApiKeys:      []string{},
+		HmacKeys:     []string{},
+		Ed25519Keys:  []string{},
+		MaxClockSkew: 30,
+	}
+}
@@ -0,0 +1,61 @@
+package config_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/babylonlabs-io/covenant-signer/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthConfigRejectsDuplicatedKeys(t *testing.T) {
+	apiKeyHash := sha256.Sum256([]byte("secret-api-key"))
+	edKey := strings.Repeat("01", 32)
+
+	tests := []struct {
+		name string
+		cfg  *config.AuthConfig
+	}{
+		{
+			name: "duplicated client id",
+			cfg: &config.AuthConfig{
+				ApiKeys:      []string{"client:" + hex.EncodeToString(apiKeyHash[:])},
+				Ed25519Keys:  []string{"client:" + edKey},
+				MaxClockSkew: 30,
+			},
+		},
+		{
+			name: "duplicated api key hash",
+			cfg: &config.AuthConfig{
+				ApiKeys: []string{
+					"client-1:" + hex.EncodeToString(apiKeyHash[:]),
+					"client-2:" + hex.EncodeToString(apiKeyHash[:]),
+				},
+			},
+		},
+		{
+			name: "duplicated ed25519 key",
+			cfg: &config.AuthConfig{
+				Ed25519Keys:  []string{"client-1:" + edKey, "client-2:" + edKey},
+				MaxClockSkew: 30,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.cfg.Parse()
+			require.Error(t, err)
+		})
+	}
+
+	parsed, err := (&config.AuthConfig{
+		ApiKeys:      []string{"client-1:" + hex.EncodeToString(apiKeyHash[:])},
+		Ed25519Keys:  []string{"client-2:" + edKey},
+		MaxClockSkew: 30,
+	}).Parse()
+	require.NoError(t, err)
+	require.Equal(t, "client-1", parsed.ApiKeyHashes[apiKeyHash])
+}
@@ -22,6 +22,12 @@ type Config struct {
 	Server          ServerConfig    `mapstructure:"server-config"`
 	Metrics         MetricsConfig   `mapstructure:"metrics"`
 	SignerAppConfig SignerAppConfig `mapstructure:"signer-app-config"`
+	Params          ParamsConfig    `mapstructure:"params-config"`
+	Auth            AuthConfig      `mapstructure:"auth"`
+	RateLimit       RateLimitConfig `mapstructure:"rate-limit"`
+	Tracing         TracingConfig   `mapstructure:"tracing"`
+	Logging         LoggingConfig   `mapstructure:"logging"`
+	Admin           AdminConfig     `mapstructure:"admin"`
 }
 
 func DefaultConfig() *Config {
@@ -31,6 +37,12 @@ func DefaultConfig() *Config {
 		Server:          *DefaultServerConfig(),
 		Metrics:         *DefaultMetricsConfig(),
 		SignerAppConfig: *DefaultSignerAppConfig(),
+		Params:          *DefaultParamsConfig(),
+		Auth:            *DefaultAuthConfig(),
+		RateLimit:       *DefaultRateLimitConfig(),
+		Tracing:         *DefaultTracingConfig(),
+		Logging:         *DefaultLoggingConfig(),
+		Admin:           *DefaultAdminConfig(),
 	}
 }
 
@@ -40,6 +52,12 @@ type ParsedConfig struct {
 	ServerConfig    *ParsedServerConfig
 	MetricsConfig   *ParsedMetricsConfig
 	SignerAppConfig *ParsedSignerAppConfig
+	ParamsConfig    *ParsedParamsConfig
+	AuthConfig      *ParsedAuthConfig
+	RateLimitConfig *ParsedRateLimitConfig
+	TracingConfig   *ParsedTracingConfig
+	LoggingConfig   *ParsedLoggingConfig
+	AdminConfig     *ParsedAdminConfig
 }
 
 func (cfg *Config) Parse() (*ParsedConfig, error) {
@@ -72,12 +90,58 @@ func (cfg *Config) Parse() (*ParsedConfig, error) {
 		return nil, err
 	}
 
+	paramsConfig, err := cfg.Params.Parse()
+
+	if err != nil {
+		return nil, err
+	}
+
+	authConfig, err := cfg.Auth.Parse()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimitConfig, err := cfg.RateLimit.Parse()
+
+	if err != nil {
+		return nil, err
+	}
+
+	tracingConfig, err := cfg.Tracing.Parse()
+
+	if err != nil {
+		return nil, err
+	}
+
+	loggingConfig, err := cfg.Logging.Parse()
+
+	if err != nil {
+		return nil, err
+	}
+
+	adminConfig, err := cfg.Admin.Parse()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if adminConfig.Enabled() && adminConfig.Port == serverConfig.Port {
+		return nil, fmt.Errorf("admin server port must be different from http port")
+	}
+
 	return &ParsedConfig{
 		BtcNodeConfig:   btcConfig,
 		BtcSignerConfig: btcSignerConfig,
 		ServerConfig:    serverConfig,
 		MetricsConfig:   metricsConfig,
 		SignerAppConfig: signerAppConfig,
+		ParamsConfig:    paramsConfig,
+		AuthConfig:      authConfig,
+		RateLimitConfig: rateLimitConfig,
+		TracingConfig:   tracingConfig,
+		LoggingConfig:   loggingConfig,
+		AdminConfig:     adminConfig,
 	}, nil
 }
 
@@ -130,16 +194,152 @@ idle-timeout = {{ .Server.IdleTimeout }}
 # Max content length in bytes
 max-content-length = {{ .Server.MaxContentLength }}
 
+# The port of gRPC server exposing the same operations as http server. gRPC
+# server listens on the same host. Set to 0 to disable gRPC server
+grpc-port = {{ .Server.GrpcPort }}
+
+# Ranges of addresses allowed to send requests, e.g. ["10.0.0.0/24"]. Requests
+# from other addresses are rejected. Empty list allows all addresses
+allowed-cidrs = [{{ range $i, $c := .Server.AllowedCidrs }}{{ if $i }}, {{ end }}"{{ $c }}"{{ end }}]
+
+# Ranges of addresses of reverse proxies (e.g. nginx) in front of the signer.
+# For requests sent by them, the client address is read from X-Forwarded-For
+# header. Empty list ignores the header
+trusted-proxies = [{{ range $i, $c := .Server.TrustedProxies }}{{ if $i }}, {{ end }}"{{ $c }}"{{ end }}]
+
 [metrics]
 # The prometheus server host
 host = "{{ .Metrics.Host }}"
 # The prometheus server port
 port = {{ .Metrics.Port }}
+# Interval in seconds of connectivity probes of the bitcoind full node and
+# wallet, 0 disables the probes
+btc-probe-interval = {{ .Metrics.BtcProbeInterval }}
 
 [signer-app-config]
 # The maximum height of staking transaction
 # Max value is 4294967295
 max-staking-transaction-height = {{ .SignerAppConfig.MaxStakingTransactionHeight }}
+# Method used to sign transactions with covenant key (psbt|privkey)
+# - psbt: psbt packets are signed by bitcoind wallet
+# - privkey: private key is dumped from bitcoind wallet for each signing and
+# zeroed afterwards. Required to sign slashing transactions, as bitcoind wallet
+# is not able to create adaptor signatures.
+signer-type = "{{ .SignerAppConfig.SignerType }}"
+# Max number of requests in a single batch signing request. Batch request body
+# can be max-batch-size times larger than max-content-length
+max-batch-size = {{ .SignerAppConfig.MaxBatchSize }}
+# Max number of requests from a batch validated and signed concurrently
+batch-concurrency = {{ .SignerAppConfig.BatchConcurrency }}
+# Sentinel file which freezes signing while it exists. It is created when
+# signing is frozen with SIGUSR1 or the admin server, so that signing stays
+# frozen after restart until the file is removed. Relative path is resolved
+# against directory of the config file
+freeze-file = "{{ .SignerAppConfig.FreezeFile }}"
+# Json file with additional rules of unbonding signing, e.g. blocked stakers
+# or cap of unbonded value per hour. Relative path is resolved against
+# directory of the config file. Empty disables the policy
+policy-file = "{{ .SignerAppConfig.PolicyFile }}"
+
+[params-config]
+# Source of global params (file|remote|babylon)
+# - file: params are read from the --params file and reloaded when it changes
+# - remote: params are polled from remote-url and verified params are cached
+# in cache-file, which is used on startup if remote is unreachable
+# - babylon: versioned staking params are polled from babylon-node-url
+source = "{{ .Params.Source }}"
+# Https url of the global params file, used only with remote source
+remote-url = "{{ .Params.RemoteUrl }}"
+# Https url of the hex encoded BIP340 signature over sha256 of the params file.
+# Defaults to remote-url with .sig suffix
+signature-url = "{{ .Params.SignatureUrl }}"
+# Remote or babylon params poll interval in seconds
+poll-interval = {{ .Params.PollInterval }}
+# Hex encoded sha256 of the params file. If set, only params matching the hash
+# are accepted
+pinned-sha256 = "{{ .Params.PinnedSha256 }}"
+# Hex encoded 32 byte x-only public key. If set, only params signed by this key
+# are accepted. If both pinned-sha256 and signature-public-key are set, params
+# must pass both checks
+signature-public-key = "{{ .Params.SignaturePublicKey }}"
+# File caching verified remote params and their signature, relative to the
+# config directory. Used only with remote source, the --params file is never
+# overwritten
+cache-file = "{{ .Params.CacheFile }}"
+# Babylon node REST endpoint, used only with babylon source
+babylon-node-url = "{{ .Params.BabylonNodeUrl }}"
+# Hex encoded 4 byte tag of phase-1 staking transactions, used only with babylon
+# source as Babylon node params do not contain it
+staking-tag = "{{ .Params.StakingTag }}"
+
+[auth]
+# Clients of the signing server. If no keys are configured, requests are not
+# authenticated. Each entry has format "<client-id>:<hex value>", client id is
+# attached to logs and metrics of the client requests.
+# Sha256 hashes of api keys sent in X-Api-Key header
+api-keys = [{{ range $i, $k := .Auth.ApiKeys }}{{ if $i }}, {{ end }}"{{ $k }}"{{ end }}]
+# Secrets (at least 32 bytes) of clients sending HMAC-SHA256 signed requests
+hmac-keys = [{{ range $i, $k := .Auth.HmacKeys }}{{ if $i }}, {{ end }}"{{ $k }}"{{ end }}]
+# Public keys of clients sending Ed25519 signed requests
+ed25519-keys = [{{ range $i, $k := .Auth.Ed25519Keys }}{{ if $i }}, {{ end }}"{{ $k }}"{{ end }}]
+# Max difference in seconds between timestamp of signed request and signer clock
+max-clock-skew = {{ .Auth.MaxClockSkew }}
+
+[rate-limit]
+# Rate of requests allowed for single client. Clients are identified by the
+# authenticated client id or by the ip address. Set to 0 to disable the limit
+requests-per-second = {{ .RateLimit.RequestsPerSecond }}
+# Number of requests single client can send at once
+burst = {{ .RateLimit.Burst }}
+# Max number of signing requests handled at the same time by all clients.
+# Set to 0 to disable the limit
+max-concurrent-requests = {{ .RateLimit.MaxConcurrentRequests }}
+
+[tracing]
+# Export OpenTelemetry traces of requests. W3C traceparent header of incoming
+# requests is respected regardless of this setting
+enabled = {{ .Tracing.Enabled }}
+# Host and port of OTLP gRPC collector
+otlp-endpoint = "{{ .Tracing.OtlpEndpoint }}"
+# Connect to the collector without TLS
+insecure = {{ .Tracing.Insecure }}
+# Ratio of traces started by the signer which are exported, between 0 and 1.
+# Traces started by clients follow their sampling decision
+sample-ratio = {{ .Tracing.SampleRatio }}
+# Name of the service in exported traces
+service-name = "{{ .Tracing.ServiceName }}"
+
+[logging]
+# Default level of logs of all components (trace|debug|info|warn|error)
+level = "{{ .Logging.Level }}"
+# Format of logs (json|console)
+format = "{{ .Logging.Format }}"
+# File to write logs to. Logs are written to stderr if empty
+file = "{{ .Logging.File }}"
+# Size in megabytes of the log file after which it is rotated
+max-size = {{ .Logging.MaxSize }}
+# Number of rotated log files to keep, 0 keeps all of them
+max-backups = {{ .Logging.MaxBackups }}
+# Number of days to keep rotated log files, 0 keeps them regardless of age
+max-age = {{ .Logging.MaxAge }}
+# Compress rotated log files with gzip
+compress = {{ .Logging.Compress }}
+# Levels of single components overriding the default level. Each entry has
+# format "<component>:<level>", components are server (http and gRPC requests),
+# params, btc, metrics and audit (signing decisions) e.g. ["server:debug", "btc:warn"]
+component-levels = [{{ range $i, $c := .Logging.ComponentLevels }}{{ if $i }}, {{ end }}"{{ $c }}"{{ end }}]
+# Replace signatures, transactions and psbt packets in logs with [REDACTED]
+redact = {{ .Logging.Redact }}
+
+[admin]
+# Loopback address of the admin server, which allows to inspect the config,
+# params and backends, pause signing, reload params and change log levels
+host = "{{ .Admin.Host }}"
+# Port of the admin server. Set to 0 to disable the admin server
+port = {{ .Admin.Port }}
+# Sha256 hash of the token sent in "Authorization: Bearer <token>" header of
+# admin requests, required if the admin server is enabled
+token-hash = "{{ .Admin.TokenHash }}"
 `
 
 var configTemplate *template.Template
@@ -154,14 +354,38 @@ func init() {
 	}
 }
 
-func writeConfigToFile(configFilePath string, config *Config) error {
+// Render returns the config in the format of the config file
+func Render(config *Config) []byte {
 	var buffer bytes.Buffer
 
 	if err := configTemplate.Execute(&buffer, config); err != nil {
 		panic(err)
 	}
 
-	return os.WriteFile(configFilePath, buffer.Bytes(), 0o600)
+	return buffer.Bytes()
+}
+
+// redactedValue replaces secrets in config returned by Redacted
+const redactedValue = "[REDACTED]"
+
+// Redacted returns copy of the config with bitcoind passwords and HMAC
+// secrets replaced, so that it can be shown to operators
+func (cfg *Config) Redacted() *Config {
+	redacted := *cfg
+	redacted.BtcNodeConfig.Pass = redactedValue
+	redacted.BtcSignerConfig.Pass = redactedValue
+
+	redacted.Auth.HmacKeys = make([]string, len(cfg.Auth.HmacKeys))
+	for i, entry := range cfg.Auth.HmacKeys {
+		clientId, _, _ := strings.Cut(entry, ":")
+		redacted.Auth.HmacKeys[i] = clientId + ":" + redactedValue
+	}
+
+	return &redacted
+}
+
+func writeConfigToFile(configFilePath string, config *Config) error {
+	return os.WriteFile(configFilePath, Render(config), 0o600)
 }
 
 func WriteConfigToFile(pathToConfFile string, conf *Config) error {
@@ -0,0 +1,126 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	LogFormatJson    = "json"
+	LogFormatConsole = "console"
+)
+
+// LoggingConfig defines level, format and output of logs
+type LoggingConfig struct {
+	// Level is the default level of all components (trace|debug|info|warn|error)
+	Level string `mapstructure:"level"`
+	// Format of log lines (json|console)
+	Format string `mapstructure:"format"`
+	// File to write logs to, logs are written to stderr if empty
+	File string `mapstructure:"file"`
+	// MaxSize is size in megabytes of the log file after which it is rotated
+	MaxSize int `mapstructure:"max-size"`
+	// MaxBackups is number of rotated log files which are kept, 0 keeps all
+	MaxBackups int `mapstructure:"max-backups"`
+	// MaxAge is number of days rotated log files are kept, 0 keeps them
+	// regardless of age
+	MaxAge int `mapstructure:"max-age"`
+	// Compress rotated log files with gzip
+	Compress bool `mapstructure:"compress"`
+	// ComponentLevels override the default level of single components. Each
+	// entry has format <component>:<level>
+	ComponentLevels []string `mapstructure:"component-levels"`
+	// Redact replaces signatures, transactions and psbt packets in logs
+	Redact bool `mapstructure:"redact"`
+}
+
+type ParsedLoggingConfig struct {
+	Level           zerolog.Level
+	Format          string
+	File            string
+	MaxSize         int
+	MaxBackups      int
+	MaxAge          int
+	Compress        bool
+	ComponentLevels map[string]zerolog.Level
+	Redact          bool
+}
+
+// ParseLogLevel parses one of the log levels allowed in the config
+func ParseLogLevel(level string) (zerolog.Level, error) {
+	switch level {
+	case "trace", "debug", "info", "warn", "error":
+		return zerolog.ParseLevel(level)
+	default:
+		return zerolog.NoLevel, fmt.Errorf("invalid log level %q, expected one of trace|debug|info|warn|error", level)
+	}
+}
+
+func (cfg *LoggingConfig) Parse() (*ParsedLoggingConfig, error) {
+	level, err := ParseLogLevel(cfg.Level)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Format != LogFormatJson && cfg.Format != LogFormatConsole {
+		return nil, fmt.Errorf("invalid log format %q, expected one of json|console", cfg.Format)
+	}
+
+	if cfg.File != "" && cfg.MaxSize <= 0 {
+		return nil, fmt.Errorf("max size of log file must be positive")
+	}
+
+	if cfg.MaxBackups < 0 || cfg.MaxAge < 0 {
+		return nil, fmt.Errorf("max backups and max age of log files must not be negative")
+	}
+
+	componentLevels := make(map[string]zerolog.Level)
+	for _, entry := range cfg.ComponentLevels {
+		component, componentLevel, found := strings.Cut(entry, ":")
+
+		if !found || component == "" {
+			return nil, fmt.Errorf("invalid component level %q, expected <component>:<level>", entry)
+		}
+
+		if _, ok := componentLevels[component]; ok {
+			return nil, fmt.Errorf("duplicated level of log component %s", component)
+		}
+
+		parsedLevel, err := ParseLogLevel(componentLevel)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid level of log component %s: %w", component, err)
+		}
+
+		componentLevels[component] = parsedLevel
+	}
+
+	return &ParsedLoggingConfig{
+		Level:           level,
+		Format:          cfg.Format,
+		File:            cfg.File,
+		MaxSize:         cfg.MaxSize,
+		MaxBackups:      cfg.MaxBackups,
+		MaxAge:          cfg.MaxAge,
+		Compress:        cfg.Compress,
+		ComponentLevels: componentLevels,
+		Redact:          cfg.Redact,
+	}, nil
+}
+
+func DefaultLoggingConfig() *LoggingConfig {
+	return &LoggingConfig{
+		Level:           "info",
+		Format:          LogFormatJson,
+		File:            "",
+		MaxSize:         100,
+		MaxBackups:      10,
+		MaxAge:          30,
+		Compress:        false,
+		ComponentLevels: []string{},
+		Redact:          true,
+	}
+}
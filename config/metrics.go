@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"net"
+	"time"
 )
 
 // MetricsConfig defines the server's metric configuration
@@ -11,11 +12,15 @@ type MetricsConfig struct {
 	Host string `mapstructure:"host"`
 	// Port of the prometheus server
 	Port int `mapstructure:"port"`
+	// Interval in seconds of connectivity probes of bitcoind connections,
+	// 0 disables the probes
+	BtcProbeInterval uint32 `mapstructure:"btc-probe-interval"`
 }
 
 type ParsedMetricsConfig struct {
-	Host string
-	Port int
+	Host             string
+	Port             int
+	BtcProbeInterval time.Duration
 }
 
 func (cfg *MetricsConfig) Parse() (*ParsedMetricsConfig, error) {
@@ -29,8 +34,9 @@ func (cfg *MetricsConfig) Parse() (*ParsedMetricsConfig, error) {
 	}
 
 	return &ParsedMetricsConfig{
-		Host: cfg.Host,
-		Port: cfg.Port,
+		Host:             cfg.Host,
+		Port:             cfg.Port,
+		BtcProbeInterval: time.Duration(cfg.BtcProbeInterval) * time.Second,
 	}, nil
 }
 
@@ -40,7 +46,8 @@ func (cfg *MetricsConfig) GetMetricsPort() int {
 
 func DefaultMetricsConfig() *MetricsConfig {
 	return &MetricsConfig{
-		Host: "127.0.0.1",
-		Port: 2112,
+		Host:             "127.0.0.1",
+		Port:             2112,
+		BtcProbeInterval: 30,
 	}
 }
@@ -0,0 +1,173 @@
+package config
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+)
+
+const stakingTagLength = 4
+
+type ParamsSource string
+
+const (
+	// params are read from local global params file
+	ParamsSourceFile ParamsSource = "file"
+	// params are polled from remote https url and cached in the params cache file
+	ParamsSourceRemote ParamsSource = "remote"
+	// params are polled from Babylon node staking params query
+	ParamsSourceBabylon ParamsSource = "babylon"
+)
+
+type ParamsConfig struct {
+	Source             string `mapstructure:"source"`
+	RemoteUrl          string `mapstructure:"remote-url"`
+	SignatureUrl       string `mapstructure:"signature-url"`
+	PollInterval       uint32 `mapstructure:"poll-interval"`
+	PinnedSha256       string `mapstructure:"pinned-sha256"`
+	SignaturePublicKey string `mapstructure:"signature-public-key"`
+	BabylonNodeUrl     string `mapstructure:"babylon-node-url"`
+	StakingTag         string `mapstructure:"staking-tag"`
+	CacheFile          string `mapstructure:"cache-file"`
+}
+
+type ParsedParamsConfig struct {
+	Source       ParamsSource
+	RemoteUrl    string
+	SignatureUrl string
+	PollInterval time.Duration
+	// nil if params are not pinned to specific hash
+	PinnedSha256 []byte
+	// nil if params signature is not verified
+	SignaturePublicKey *btcec.PublicKey
+	BabylonNodeUrl     string
+	// tag of phase-1 staking transactions, Babylon node params do not contain it
+	StakingTag []byte
+	// file caching verified remote params, relative to the config directory
+	CacheFile string
+}
+
+func (c *ParamsConfig) Parse() (*ParsedParamsConfig, error) {
+	switch ParamsSource(c.Source) {
+	case ParamsSourceFile:
+		return &ParsedParamsConfig{
+			Source: ParamsSourceFile,
+		}, nil
+	case ParamsSourceRemote:
+		return c.parseRemote()
+	case ParamsSourceBabylon:
+		return c.parseBabylon()
+	default:
+		return nil, fmt.Errorf("unknown params source %s", c.Source)
+	}
+}
+
+func parseHttpsUrl(rawUrl string) error {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("url must be a valid https url, got: %s", rawUrl)
+	}
+
+	return nil
+}
+
+func (c *ParamsConfig) parseRemote() (*ParsedParamsConfig, error) {
+	if err := parseHttpsUrl(c.RemoteUrl); err != nil {
+		return nil, fmt.Errorf("invalid params remote url: %w", err)
+	}
+
+	if c.PollInterval == 0 {
+		return nil, fmt.Errorf("params poll interval must be positive")
+	}
+
+	if c.PinnedSha256 == "" && c.SignaturePublicKey == "" {
+		return nil, fmt.Errorf("remote params source requires pinned-sha256 or signature-public-key to be set")
+	}
+
+	if c.CacheFile == "" {
+		return nil, fmt.Errorf("remote params source requires cache-file to be set")
+	}
+
+	parsed := &ParsedParamsConfig{
+		Source:       ParamsSourceRemote,
+		RemoteUrl:    c.RemoteUrl,
+		PollInterval: time.Duration(c.PollInterval) * time.Second,
+		CacheFile:    c.CacheFile,
+	}
+
+	if c.PinnedSha256 != "" {
+		hash, err := hex.DecodeString(c.PinnedSha256)
+		if err != nil || len(hash) != 32 {
+			return nil, fmt.Errorf("invalid pinned-sha256, expected 32 bytes hex encoded hash")
+		}
+		parsed.PinnedSha256 = hash
+	}
+
+	if c.SignaturePublicKey != "" {
+		keyBytes, err := hex.DecodeString(c.SignaturePublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature-public-key: %w", err)
+		}
+
+		key, err := schnorr.ParsePubKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature-public-key: %w", err)
+		}
+		parsed.SignaturePublicKey = key
+
+		signatureUrl := c.SignatureUrl
+		if signatureUrl == "" {
+			signatureUrl = c.RemoteUrl + ".sig"
+		}
+
+		if err := parseHttpsUrl(signatureUrl); err != nil {
+			return nil, fmt.Errorf("invalid params signature url: %w", err)
+		}
+		parsed.SignatureUrl = signatureUrl
+	}
+
+	return parsed, nil
+}
+
+func (c *ParamsConfig) parseBabylon() (*ParsedParamsConfig, error) {
+	u, err := url.Parse(c.BabylonNodeUrl)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid babylon node url: %s", c.BabylonNodeUrl)
+	}
+
+	if c.PollInterval == 0 {
+		return nil, fmt.Errorf("params poll interval must be positive")
+	}
+
+	var tag []byte
+	if c.StakingTag != "" {
+		tag, err = hex.DecodeString(c.StakingTag)
+		if err != nil || len(tag) != stakingTagLength {
+			return nil, fmt.Errorf("invalid staking tag, expected %d bytes hex encoded tag", stakingTagLength)
+		}
+	}
+
+	return &ParsedParamsConfig{
+		Source:         ParamsSourceBabylon,
+		PollInterval:   time.Duration(c.PollInterval) * time.Second,
+		BabylonNodeUrl: strings.TrimSuffix(c.BabylonNodeUrl, "/"),
+		StakingTag:     tag,
+	}, nil
+}
+
+func DefaultParamsConfig() *ParamsConfig {
+	return &ParamsConfig{
+		Source:       string(ParamsSourceFile),
+		PollInterval: 600,
+		CacheFile:    "global-params.cache.json",
+	}
+}
@@ -0,0 +1,49 @@
+package config
+
+import "fmt"
+
+// RateLimitConfig defines limits of requests handled by the signing server
+type RateLimitConfig struct {
+	// RequestsPerSecond is rate of requests allowed for single client, 0
+	// disables per client limit
+	RequestsPerSecond float64 `mapstructure:"requests-per-second"`
+	// Burst is number of requests single client can send at once
+	Burst int `mapstructure:"burst"`
+	// MaxConcurrentRequests is max number of signing requests handled at the
+	// same time by all clients, 0 disables the limit
+	MaxConcurrentRequests int `mapstructure:"max-concurrent-requests"`
+}
+
+type ParsedRateLimitConfig struct {
+	RequestsPerSecond     float64
+	Burst                 int
+	MaxConcurrentRequests int
+}
+
+func (c *RateLimitConfig) Parse() (*ParsedRateLimitConfig, error) {
+	if c.RequestsPerSecond < 0 {
+		return nil, fmt.Errorf("requests per second must not be negative")
+	}
+
+	if c.RequestsPerSecond > 0 && c.Burst <= 0 {
+		return nil, fmt.Errorf("burst must be positive if requests per second is set")
+	}
+
+	if c.MaxConcurrentRequests < 0 {
+		return nil, fmt.Errorf("max concurrent requests must not be negative")
+	}
+
+	return &ParsedRateLimitConfig{
+		RequestsPerSecond:     c.RequestsPerSecond,
+		Burst:                 c.Burst,
+		MaxConcurrentRequests: c.MaxConcurrentRequests,
+	}, nil
+}
+
+func DefaultRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		RequestsPerSecond:     20,
+		Burst:                 40,
+		MaxConcurrentRequests: 50,
+	}
+}
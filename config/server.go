@@ -1,6 +1,10 @@
 package config
 
-import "time"
+import (
+	"fmt"
+	"net/netip"
+	"time"
+)
 
 type ServerConfig struct {
 	Host             string `mapstructure:"host"`
@@ -9,6 +13,14 @@ type ServerConfig struct {
 	ReadTimeout      uint32 `mapstructure:"read-timeout"`
 	IdleTimeout      uint32 `mapstructure:"idle-timeout"`
 	MaxContentLength uint32 `mapstructure:"max-content-length"`
+	// GrpcPort is port of gRPC server, 0 disables gRPC server
+	GrpcPort int `mapstructure:"grpc-port"`
+	// AllowedCidrs are ranges of addresses allowed to send requests, empty
+	// list allows all addresses
+	AllowedCidrs []string `mapstructure:"allowed-cidrs"`
+	// TrustedProxies are ranges of addresses of reverse proxies whose
+	// X-Forwarded-For header is used to find address of the client
+	TrustedProxies []string `mapstructure:"trusted-proxies"`
 }
 
 type ParsedServerConfig struct {
@@ -18,10 +30,31 @@ type ParsedServerConfig struct {
 	ReadTimeout      time.Duration
 	IdleTimeout      time.Duration
 	MaxContentLength uint32
+	GrpcPort         int
+	AllowedCidrs     []netip.Prefix
+	TrustedProxies   []netip.Prefix
 }
 
 func (c *ServerConfig) Parse() (*ParsedServerConfig, error) {
 	// TODO Add some validations
+	if c.GrpcPort < 0 || c.GrpcPort > 65535 {
+		return nil, fmt.Errorf("grpc port must be between 0 and 65535 (inclusive)")
+	}
+
+	if c.GrpcPort != 0 && c.GrpcPort == c.Port {
+		return nil, fmt.Errorf("grpc port must be different from http port")
+	}
+
+	allowedCidrs, err := parsePrefixes(c.AllowedCidrs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allowed cidrs: %w", err)
+	}
+
+	trustedProxies, err := parsePrefixes(c.TrustedProxies)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies: %w", err)
+	}
+
 	return &ParsedServerConfig{
 		Host:             c.Host,
 		Port:             c.Port,
@@ -29,9 +62,31 @@ func (c *ServerConfig) Parse() (*ParsedServerConfig, error) {
 		ReadTimeout:      time.Duration(c.ReadTimeout) * time.Second,
 		IdleTimeout:      time.Duration(c.IdleTimeout) * time.Second,
 		MaxContentLength: c.MaxContentLength,
+		GrpcPort:         c.GrpcPort,
+		AllowedCidrs:     allowedCidrs,
+		TrustedProxies:   trustedProxies,
 	}, nil
 }
 
+// parsePrefixes parses CIDR ranges, single addresses are accepted as ranges
+// containing only the address
+func parsePrefixes(cidrs []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(cidr)
+			if addrErr != nil {
+				return nil, err
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
+
 func DefaultServerConfig() *ServerConfig {
 	return &ServerConfig{
 		Host:             "127.0.0.1",
@@ -40,5 +95,8 @@ func DefaultServerConfig() *ServerConfig {
 		ReadTimeout:      15,
 		IdleTimeout:      120,
 		MaxContentLength: 8192,
+		GrpcPort:         0,
+		AllowedCidrs:     []string{},
+		TrustedProxies:   []string{},
 	}
 }
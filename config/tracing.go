@@ -0,0 +1,61 @@
+package config
+
+import (
+	"fmt"
+)
+
+// TracingConfig defines export of OpenTelemetry traces of requests
+type TracingConfig struct {
+	// Enabled enables export of traces, trace ids of incoming requests are
+	// logged regardless of this setting
+	Enabled bool `mapstructure:"enabled"`
+	// OtlpEndpoint is host:port of OTLP gRPC collector
+	OtlpEndpoint string `mapstructure:"otlp-endpoint"`
+	// Insecure disables TLS of connection to the collector
+	Insecure bool `mapstructure:"insecure"`
+	// SampleRatio is ratio of traces started by the signer which are
+	// exported. Traces started by clients follow their sampling decision.
+	SampleRatio float64 `mapstructure:"sample-ratio"`
+	// ServiceName identifies the signer in exported traces
+	ServiceName string `mapstructure:"service-name"`
+}
+
+type ParsedTracingConfig struct {
+	Enabled      bool
+	OtlpEndpoint string
+	Insecure     bool
+	SampleRatio  float64
+	ServiceName  string
+}
+
+func (cfg *TracingConfig) Parse() (*ParsedTracingConfig, error) {
+	if cfg.Enabled && cfg.OtlpEndpoint == "" {
+		return nil, fmt.Errorf("otlp endpoint is required if tracing is enabled")
+	}
+
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return nil, fmt.Errorf("sample ratio must be between 0 and 1 (inclusive)")
+	}
+
+	if cfg.ServiceName == "" {
+		return nil, fmt.Errorf("service name must not be empty")
+	}
+
+	return &ParsedTracingConfig{
+		Enabled:      cfg.Enabled,
+		OtlpEndpoint: cfg.OtlpEndpoint,
+		Insecure:     cfg.Insecure,
+		SampleRatio:  cfg.SampleRatio,
+		ServiceName:  cfg.ServiceName,
+	}, nil
+}
+
+func DefaultTracingConfig() *TracingConfig {
+	return &TracingConfig{
+		Enabled:      false,
+		OtlpEndpoint: "127.0.0.1:4317",
+		Insecure:     true,
+		SampleRatio:  1,
+		ServiceName:  "covenant-signer",
+	}
+}
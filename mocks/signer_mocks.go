@@ -10,6 +10,7 @@ import (
 
 	signerapp "github.com/babylonlabs-io/covenant-signer/signerapp"
 	chainhash "github.com/btcsuite/btcd/chaincfg/chainhash"
+	wire "github.com/btcsuite/btcd/wire"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -89,6 +90,21 @@ func (mr *MockBtcChainInfoMockRecorder) BestBlockHeight(ctx interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BestBlockHeight", reflect.TypeOf((*MockBtcChainInfo)(nil).BestBlockHeight), ctx)
 }
 
+// BlockHashByHeight mocks base method.
+func (m *MockBtcChainInfo) BlockHashByHeight(ctx context.Context, height uint32) (*chainhash.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockHashByHeight", ctx, height)
+	ret0, _ := ret[0].(*chainhash.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockHashByHeight indicates an expected call of BlockHashByHeight.
+func (mr *MockBtcChainInfoMockRecorder) BlockHashByHeight(ctx, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockHashByHeight", reflect.TypeOf((*MockBtcChainInfo)(nil).BlockHashByHeight), ctx, height)
+}
+
 // TxByHash mocks base method.
 func (m *MockBtcChainInfo) TxByHash(ctx context.Context, txHash *chainhash.Hash, pkScript []byte) (*signerapp.TxInfo, error) {
 	m.ctrl.T.Helper()
@@ -104,6 +120,21 @@ func (mr *MockBtcChainInfoMockRecorder) TxByHash(ctx, txHash, pkScript interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxByHash", reflect.TypeOf((*MockBtcChainInfo)(nil).TxByHash), ctx, txHash, pkScript)
 }
 
+// UnspentTxOut mocks base method.
+func (m *MockBtcChainInfo) UnspentTxOut(ctx context.Context, outpoint *wire.OutPoint) (*wire.TxOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnspentTxOut", ctx, outpoint)
+	ret0, _ := ret[0].(*wire.TxOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnspentTxOut indicates an expected call of UnspentTxOut.
+func (mr *MockBtcChainInfoMockRecorder) UnspentTxOut(ctx, outpoint interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnspentTxOut", reflect.TypeOf((*MockBtcChainInfo)(nil).UnspentTxOut), ctx, outpoint)
+}
+
 // MockExternalBtcSigner is a mock of ExternalBtcSigner interface.
 type MockExternalBtcSigner struct {
 	ctrl     *gomock.Controller
@@ -127,6 +158,21 @@ func (m *MockExternalBtcSigner) EXPECT() *MockExternalBtcSignerMockRecorder {
 	return m.recorder
 }
 
+// AdaptorSignatures mocks base method.
+func (m *MockExternalBtcSigner) AdaptorSignatures(ctx context.Context, request *signerapp.AdaptorSigningRequest) (*signerapp.AdaptorSigningResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdaptorSignatures", ctx, request)
+	ret0, _ := ret[0].(*signerapp.AdaptorSigningResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdaptorSignatures indicates an expected call of AdaptorSignatures.
+func (mr *MockExternalBtcSignerMockRecorder) AdaptorSignatures(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdaptorSignatures", reflect.TypeOf((*MockExternalBtcSigner)(nil).AdaptorSignatures), ctx, request)
+}
+
 // RawSignature mocks base method.
 func (m *MockExternalBtcSigner) RawSignature(ctx context.Context, request *signerapp.SigningRequest) (*signerapp.SigningResult, error) {
 	m.ctrl.T.Helper()
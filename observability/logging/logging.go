@@ -0,0 +1,179 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/babylonlabs-io/covenant-signer/config"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Components of the signer with separately configurable log level
+const (
+	// ComponentServer logs http and gRPC requests
+	ComponentServer = "server"
+	// ComponentParams logs loading of global params
+	ComponentParams = "params"
+	// ComponentBtc logs connections to bitcoind
+	ComponentBtc = "btc"
+	// ComponentMetrics logs the prometheus server
+	ComponentMetrics = "metrics"
+	// ComponentAudit logs signing decisions with the client which requested them
+	ComponentAudit = "audit"
+)
+
+var Components = []string{ComponentServer, ComponentParams, ComponentBtc, ComponentMetrics, ComponentAudit}
+
+var (
+	// mu serializes changes of levels
+	mu sync.Mutex
+	// base is logger writing to the configured output
+	base zerolog.Logger
+	// defaultLevel applies to components without level override
+	defaultLevel    zerolog.Level
+	componentLevels map[string]zerolog.Level
+	// loggers of components, nil until Init is called
+	loggers atomic.Pointer[map[string]*zerolog.Logger]
+)
+
+func isComponent(component string) bool {
+	for _, c := range Components {
+		if c == component {
+			return true
+		}
+	}
+	return false
+}
+
+// Logger returns logger of the component with its configured level. Before
+// Init is called, it returns the zerolog global logger.
+func Logger(component string) *zerolog.Logger {
+	if l := loggers.Load(); l != nil {
+		if logger, ok := (*l)[component]; ok {
+			return logger
+		}
+	}
+
+	logger := log.Logger.With().Str("component", component).Logger()
+	return &logger
+}
+
+func newOutput(cfg *config.ParsedLoggingConfig) io.WriteCloser {
+	if cfg.File == "" {
+		return nopCloser{os.Stderr}
+	}
+
+	return &lumberjack.Logger{
+		Filename:   cfg.File,
+		MaxSize:    cfg.MaxSize,
+		MaxBackups: cfg.MaxBackups,
+		MaxAge:     cfg.MaxAge,
+		Compress:   cfg.Compress,
+	}
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
+
+// Init configures loggers of all components and the zerolog global logger,
+// which is used by dependencies. Returned function closes the log file.
+func Init(cfg *config.ParsedLoggingConfig) (func() error, error) {
+	for component := range cfg.ComponentLevels {
+		if !isComponent(component) {
+			return nil, fmt.Errorf("unknown log component %s, expected one of %v", component, Components)
+		}
+	}
+
+	output := newOutput(cfg)
+
+	var w io.Writer = output
+	if cfg.Format == config.LogFormatConsole {
+		w = zerolog.ConsoleWriter{Out: output, NoColor: cfg.File != ""}
+	}
+	if cfg.Redact {
+		// redaction works on json lines, so it must be done before they are
+		// formatted for console
+		w = NewRedactingWriter(w)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	base = zerolog.New(w).With().Timestamp().Logger()
+	defaultLevel = cfg.Level
+	componentLevels = make(map[string]zerolog.Level, len(cfg.ComponentLevels))
+	for component, level := range cfg.ComponentLevels {
+		componentLevels[component] = level
+	}
+	applyLevels()
+
+	return output.Close, nil
+}
+
+// applyLevels replaces loggers of all components with loggers using current
+// levels. Must be called with mu held.
+func applyLevels() {
+	log.Logger = base.Level(defaultLevel)
+
+	l := make(map[string]*zerolog.Logger, len(Components))
+	for component, level := range levels() {
+		logger := base.Level(level).With().Str("component", component).Logger()
+		l[component] = &logger
+	}
+	loggers.Store(&l)
+}
+
+// levels returns effective level of each component. Must be called with mu
+// held.
+func levels() map[string]zerolog.Level {
+	l := make(map[string]zerolog.Level, len(Components))
+	for _, component := range Components {
+		level, ok := componentLevels[component]
+		if !ok {
+			level = defaultLevel
+		}
+		l[component] = level
+	}
+	return l
+}
+
+// Levels returns the default level and effective level of each component
+func Levels() (zerolog.Level, map[string]zerolog.Level) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return defaultLevel, levels()
+}
+
+// SetLevel changes level of the component at runtime. Empty component
+// changes the default level, which applies to components without their own
+// level.
+func SetLevel(component string, level zerolog.Level) error {
+	if component != "" && !isComponent(component) {
+		return fmt.Errorf("unknown log component %s, expected one of %v", component, Components)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if loggers.Load() == nil {
+		return fmt.Errorf("logging is not initialized")
+	}
+
+	if component == "" {
+		defaultLevel = level
+	} else {
+		componentLevels[component] = level
+	}
+	applyLevels()
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package logging_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/babylonlabs-io/covenant-signer/config"
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactingWriter(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(logging.NewRedactingWriter(&buf))
+
+	txHash := strings.Repeat("ab", 32)
+	signature := strings.Repeat("cd", 64)
+	txHex := "0200000001" + strings.Repeat("ef", 100)
+	psbt := "cHNidP8BAFICAAAAAc+tAAAAAA=="
+
+	logger.Error().
+		Str("tx_hash", txHash).
+		Str("signature", signature).
+		Str("psbt", psbt).
+		Msgf("invalid transaction %s", txHex)
+
+	var line map[string]string
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	require.Equal(t, txHash, line["tx_hash"])
+	require.Equal(t, "[REDACTED]", line["signature"])
+	require.Equal(t, "[REDACTED]", line["psbt"])
+	require.Equal(t, "invalid transaction [REDACTED]", line["message"])
+}
+
+// readLogLines returns component and message of each json line of the file
+func readLogLines(t *testing.T, path string) [][2]string {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var lines [][2]string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line map[string]string
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, [2]string{line["component"], line["message"]})
+	}
+	require.NoError(t, scanner.Err())
+
+	return lines
+}
+
+func TestComponentLevels(t *testing.T) {
+	globalLogger := log.Logger
+	defer func() { log.Logger = globalLogger }()
+
+	cfg := config.DefaultLoggingConfig()
+	cfg.File = filepath.Join(t.TempDir(), "signer.log")
+	cfg.ComponentLevels = []string{"server:debug", "btc:warn"}
+	parsed, err := cfg.Parse()
+	require.NoError(t, err)
+
+	closeLogs, err := logging.Init(parsed)
+	require.NoError(t, err)
+
+	logging.Logger(logging.ComponentServer).Debug().Msg("server debug")
+	logging.Logger(logging.ComponentBtc).Info().Msg("btc info")
+	logging.Logger(logging.ComponentBtc).Warn().Msg("btc warn")
+	logging.Logger(logging.ComponentParams).Debug().Msg("params debug")
+	logging.Logger(logging.ComponentParams).Info().Msg("params info")
+	log.Debug().Msg("global debug")
+	require.NoError(t, closeLogs())
+
+	require.Equal(t, [][2]string{
+		{"server", "server debug"},
+		{"btc", "btc warn"},
+		{"params", "params info"},
+	}, readLogLines(t, cfg.File))
+}
+
+func TestUnknownComponentIsRejected(t *testing.T) {
+	cfg := config.DefaultLoggingConfig()
+	cfg.ComponentLevels = []string{"wallet:debug"}
+	parsed, err := cfg.Parse()
+	require.NoError(t, err)
+
+	_, err = logging.Init(parsed)
+	require.ErrorContains(t, err, "unknown log component wallet")
+}
+
+func TestSetLevel(t *testing.T) {
+	globalLogger := log.Logger
+	defer func() { log.Logger = globalLogger }()
+
+	cfg := config.DefaultLoggingConfig()
+	cfg.File = filepath.Join(t.TempDir(), "signer.log")
+	cfg.ComponentLevels = []string{"btc:warn"}
+	parsed, err := cfg.Parse()
+	require.NoError(t, err)
+
+	closeLogs, err := logging.Init(parsed)
+	require.NoError(t, err)
+
+	require.NoError(t, logging.SetLevel(logging.ComponentBtc, zerolog.DebugLevel))
+	require.NoError(t, logging.SetLevel("", zerolog.ErrorLevel))
+	require.ErrorContains(t, logging.SetLevel("wallet", zerolog.DebugLevel), "unknown log component wallet")
+
+	defaultLevel, levels := logging.Levels()
+	require.Equal(t, zerolog.ErrorLevel, defaultLevel)
+	require.Equal(t, zerolog.DebugLevel, levels[logging.ComponentBtc])
+	require.Equal(t, zerolog.ErrorLevel, levels[logging.ComponentServer])
+
+	logging.Logger(logging.ComponentBtc).Debug().Msg("btc debug")
+	logging.Logger(logging.ComponentServer).Warn().Msg("server warn")
+	require.NoError(t, closeLogs())
+
+	require.Equal(t, [][2]string{{"btc", "btc debug"}}, readLogLines(t, cfg.File))
+}
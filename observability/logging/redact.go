@@ -0,0 +1,40 @@
+package logging
+
+import (
+	"io"
+	"regexp"
+)
+
+const redacted = "[REDACTED]"
+
+var (
+	// hex strings at least as long as schnorr signature. Shorter values, i.e.
+	// transaction hashes and public keys, are kept as they identify requests.
+	longHexPattern = regexp.MustCompile(`[0-9a-fA-F]{128,}`)
+	// base64 encoded psbt packets, which start with "psbt\xff" magic bytes
+	psbtPattern = regexp.MustCompile(`cHNidP8[0-9A-Za-z+/]*={0,2}`)
+)
+
+// RedactingWriter replaces signatures, serialized transactions and psbt
+// packets in log lines with [REDACTED]. Replaced values consist of characters
+// which are never escaped, so json lines stay valid.
+type RedactingWriter struct {
+	w io.Writer
+}
+
+func NewRedactingWriter(w io.Writer) *RedactingWriter {
+	return &RedactingWriter{w: w}
+}
+
+func (r *RedactingWriter) Write(p []byte) (int, error) {
+	line := longHexPattern.ReplaceAll(p, []byte(redacted))
+	line = psbtPattern.ReplaceAll(line, []byte(redacted))
+
+	if _, err := r.w.Write(line); err != nil {
+		return 0, err
+	}
+
+	// zerolog treats short writes as errors, so the length of the original
+	// line is reported
+	return len(p), nil
+}
@@ -5,10 +5,10 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
-	"github.com/rs/zerolog/log"
 )
 
 const (
@@ -45,8 +45,8 @@ func start(addr string, reg *prometheus.Registry) {
 		IdleTimeout:  metricRequestIdleTimeout,
 	}
 
-	log.Printf("Starting metrics server on %s", addr)
+	logging.Logger(logging.ComponentMetrics).Printf("Starting metrics server on %s", addr)
 	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-		log.Fatal().Err(err).Msgf("Error starting metrics server on %s", addr)
+		logging.Logger(logging.ComponentMetrics).Fatal().Err(err).Msgf("Error starting metrics server on %s", addr)
 	}
 }
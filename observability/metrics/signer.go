@@ -1,6 +1,9 @@
 package metrics
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -10,6 +13,25 @@ type CovenantSignerMetrics struct {
 	ReceivedSigningRequests   prometheus.Counter
 	SuccessfulSigningRequests prometheus.Counter
 	FailedSigningRequests     prometheus.Counter
+	GlobalParamsVersions      *prometheus.GaugeVec
+	GlobalParamsReloads       *prometheus.CounterVec
+	AuthenticatedRequests     *prometheus.CounterVec
+	AuthenticationFailures    *prometheus.CounterVec
+	RateLimits                *prometheus.GaugeVec
+	RateLimitRejections       *prometheus.CounterVec
+	InFlightRequests          prometheus.Gauge
+	RejectedSigningRequests   *prometheus.CounterVec
+	LastSuccessfulSignature   prometheus.Gauge
+	RequestDuration           *prometheus.HistogramVec
+	BtcChainLookupDuration    *prometheus.HistogramVec
+	ParamsRetrievalDuration   prometheus.Histogram
+	BtcSignerDuration         *prometheus.HistogramVec
+	BtcRpcCalls               *prometheus.CounterVec
+	BtcRpcErrors              *prometheus.CounterVec
+	BtcRpcDuration            *prometheus.HistogramVec
+	BtcRpcUp                  *prometheus.GaugeVec
+	BtcRpcProbeDuration       *prometheus.GaugeVec
+	SigningFrozen             prometheus.Gauge
 }
 
 func NewCovenantSignerMetrics() *CovenantSignerMetrics {
@@ -30,6 +52,87 @@ func NewCovenantSignerMetrics() *CovenantSignerMetrics {
 			Name: "signer_failed_signing_requests",
 			Help: "The total number of times signer responded with an internal error",
 		}),
+		GlobalParamsVersions: registerer.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "signer_global_params_version_activation_height",
+			Help: "Activation height of each global params version currently loaded by the signer",
+		}, []string{"version"}),
+		GlobalParamsReloads: registerer.NewCounterVec(prometheus.CounterOpts{
+			Name: "signer_global_params_reloads",
+			Help: "The total number of global params reload attempts by result",
+		}, []string{"result"}),
+		AuthenticatedRequests: registerer.NewCounterVec(prometheus.CounterOpts{
+			Name: "signer_authenticated_requests",
+			Help: "The total number of successfully authenticated requests by client",
+		}, []string{"client"}),
+		AuthenticationFailures: registerer.NewCounterVec(prometheus.CounterOpts{
+			Name: "signer_authentication_failures",
+			Help: "The total number of requests rejected by authentication by reason",
+		}, []string{"reason"}),
+		RateLimits: registerer.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "signer_rate_limit",
+			Help: "Configured limits of requests, 0 if the limit is disabled",
+		}, []string{"limit"}),
+		RateLimitRejections: registerer.NewCounterVec(prometheus.CounterOpts{
+			Name: "signer_rate_limit_rejections",
+			Help: "The total number of requests rejected by rate limits by the exceeded limit",
+		}, []string{"limit"}),
+		InFlightRequests: registerer.NewGauge(prometheus.GaugeOpts{
+			Name: "signer_in_flight_signing_requests",
+			Help: "The number of signing requests currently handled by the signer",
+		}),
+		RejectedSigningRequests: registerer.NewCounterVec(prometheus.CounterOpts{
+			Name: "signer_rejected_signing_requests",
+			Help: "The total number of signing requests rejected by validation by reason and covenant public key",
+		}, []string{"reason", "covenant_public_key"}),
+		LastSuccessfulSignature: registerer.NewGauge(prometheus.GaugeOpts{
+			Name: "signer_last_successful_signature_timestamp_seconds",
+			Help: "Unix time of the last successful signing request",
+		}),
+		RequestDuration: registerer.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "signer_request_duration_seconds",
+			Help:    "Duration of handling of requests by operation and result",
+			Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+		}, []string{"operation", "result"}),
+		BtcChainLookupDuration: registerer.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "signer_btc_chain_lookup_duration_seconds",
+			Help:    "Duration of btc chain lookups by method",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 12),
+		}, []string{"method"}),
+		ParamsRetrievalDuration: registerer.NewHistogram(prometheus.HistogramOpts{
+			Name:    "signer_params_retrieval_duration_seconds",
+			Help:    "Duration of retrieval of Babylon params applicable to the request",
+			Buckets: prometheus.ExponentialBuckets(0.0001, 2, 12),
+		}),
+		BtcSignerDuration: registerer.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "signer_btc_signer_duration_seconds",
+			Help:    "Duration of signature requests to the btc signer by method",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 12),
+		}, []string{"method"}),
+		BtcRpcCalls: registerer.NewCounterVec(prometheus.CounterOpts{
+			Name: "signer_btc_rpc_calls",
+			Help: "The total number of bitcoind RPC calls by connection and method",
+		}, []string{"connection", "method"}),
+		BtcRpcErrors: registerer.NewCounterVec(prometheus.CounterOpts{
+			Name: "signer_btc_rpc_errors",
+			Help: "The total number of failed bitcoind RPC calls by connection and method",
+		}, []string{"connection", "method"}),
+		BtcRpcDuration: registerer.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "signer_btc_rpc_duration_seconds",
+			Help:    "Duration of bitcoind RPC calls by connection and method",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 12),
+		}, []string{"connection", "method"}),
+		BtcRpcUp: registerer.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "signer_btc_rpc_up",
+			Help: "Whether the last connectivity probe of bitcoind connection succeeded (1) or failed (0)",
+		}, []string{"connection"}),
+		BtcRpcProbeDuration: registerer.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "signer_btc_rpc_probe_duration_seconds",
+			Help: "Duration of the last connectivity probe of bitcoind connection",
+		}, []string{"connection"}),
+		SigningFrozen: registerer.NewGauge(prometheus.GaugeOpts{
+			Name: "signer_signing_frozen",
+			Help: "Whether signing is frozen (1) or not (0)",
+		}),
 	}
 
 	return uwMetrics
@@ -41,8 +144,101 @@ func (m *CovenantSignerMetrics) IncReceivedSigningRequests() {
 
 func (m *CovenantSignerMetrics) IncSuccessfulSigningRequests() {
 	m.SuccessfulSigningRequests.Inc()
+	m.LastSuccessfulSignature.SetToCurrentTime()
 }
 
 func (m *CovenantSignerMetrics) IncFailedSigningRequests() {
 	m.FailedSigningRequests.Inc()
 }
+
+// SetGlobalParamsVersions replaces currently exported global params versions
+// with the provided mapping from version number to activation height
+func (m *CovenantSignerMetrics) SetGlobalParamsVersions(activationHeights map[uint64]uint64) {
+	m.GlobalParamsVersions.Reset()
+	for version, height := range activationHeights {
+		m.GlobalParamsVersions.WithLabelValues(strconv.FormatUint(version, 10)).Set(float64(height))
+	}
+}
+
+func (m *CovenantSignerMetrics) IncGlobalParamsReloads(success bool) {
+	result := "success"
+	if !success {
+		result = "failure"
+	}
+	m.GlobalParamsReloads.WithLabelValues(result).Inc()
+}
+
+func (m *CovenantSignerMetrics) IncAuthenticatedRequests(clientId string) {
+	m.AuthenticatedRequests.WithLabelValues(clientId).Inc()
+}
+
+func (m *CovenantSignerMetrics) IncAuthenticationFailures(reason string) {
+	m.AuthenticationFailures.WithLabelValues(reason).Inc()
+}
+
+// SetRateLimits exports configured limits of requests
+func (m *CovenantSignerMetrics) SetRateLimits(requestsPerSecond float64, burst int, maxConcurrentRequests int) {
+	m.RateLimits.WithLabelValues("requests_per_second").Set(requestsPerSecond)
+	m.RateLimits.WithLabelValues("burst").Set(float64(burst))
+	m.RateLimits.WithLabelValues("max_concurrent_requests").Set(float64(maxConcurrentRequests))
+}
+
+func (m *CovenantSignerMetrics) IncRateLimitRejections(limit string) {
+	m.RateLimitRejections.WithLabelValues(limit).Inc()
+}
+
+func (m *CovenantSignerMetrics) IncInFlightRequests() {
+	m.InFlightRequests.Inc()
+}
+
+func (m *CovenantSignerMetrics) DecInFlightRequests() {
+	m.InFlightRequests.Dec()
+}
+
+func (m *CovenantSignerMetrics) IncRejectedSigningRequests(reason string, covenantPublicKey string) {
+	m.RejectedSigningRequests.WithLabelValues(reason, covenantPublicKey).Inc()
+}
+
+func (m *CovenantSignerMetrics) ObserveRequestDuration(operation string, result string, d time.Duration) {
+	m.RequestDuration.WithLabelValues(operation, result).Observe(d.Seconds())
+}
+
+func (m *CovenantSignerMetrics) ObserveBtcChainLookupDuration(method string, d time.Duration) {
+	m.BtcChainLookupDuration.WithLabelValues(method).Observe(d.Seconds())
+}
+
+func (m *CovenantSignerMetrics) ObserveParamsRetrievalDuration(d time.Duration) {
+	m.ParamsRetrievalDuration.Observe(d.Seconds())
+}
+
+func (m *CovenantSignerMetrics) ObserveBtcSignerDuration(method string, d time.Duration) {
+	m.BtcSignerDuration.WithLabelValues(method).Observe(d.Seconds())
+}
+
+// ObserveBtcRpcCall records single bitcoind RPC call of the connection
+func (m *CovenantSignerMetrics) ObserveBtcRpcCall(connection string, method string, d time.Duration, failed bool) {
+	m.BtcRpcCalls.WithLabelValues(connection, method).Inc()
+	if failed {
+		m.BtcRpcErrors.WithLabelValues(connection, method).Inc()
+	}
+	m.BtcRpcDuration.WithLabelValues(connection, method).Observe(d.Seconds())
+}
+
+// SetBtcRpcProbe records result of connectivity probe of bitcoind connection
+func (m *CovenantSignerMetrics) SetBtcRpcProbe(connection string, up bool, d time.Duration) {
+	value := 0.0
+	if up {
+		value = 1
+	}
+	m.BtcRpcUp.WithLabelValues(connection).Set(value)
+	m.BtcRpcProbeDuration.WithLabelValues(connection).Set(d.Seconds())
+}
+
+// SetSigningFrozen records whether signing is frozen
+func (m *CovenantSignerMetrics) SetSigningFrozen(frozen bool) {
+	value := 0.0
+	if frozen {
+		value = 1
+	}
+	m.SigningFrozen.Set(value)
+}
@@ -2,7 +2,20 @@ package tracing
 
 import (
 	"context"
+	"sync"
+	"time"
+
+	"github.com/babylonlabs-io/covenant-signer/config"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type TraceContextKey string
@@ -10,20 +23,135 @@ type TraceContextKey string
 const TraceInfoKey = TraceContextKey("requestTracingInfo")
 const TraceIdKey = TraceContextKey("requestTraceId")
 
+const instrumentationName = "github.com/babylonlabs-io/covenant-signer"
+
+// propagator reads and writes W3C traceparent and tracestate headers
+var propagator = propagation.TraceContext{}
+
 type SpanDetail struct {
-	Name     string
+	Name string
+	// Duration of the span in milliseconds
 	Duration int64
 }
 
 type TracingInfo struct {
+	// spans can be recorded concurrently e.g. by batch requests
+	mu          sync.Mutex
 	SpanDetails []SpanDetail
 }
 
-func AttachTracingIntoContext(ctx context.Context) context.Context {
-	// Attach traceId into context
+func (t *TracingInfo) addSpan(span SpanDetail) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.SpanDetails = append(t.SpanDetails, span)
+}
+
+// Init starts export of spans to OTLP collector if it is enabled in the
+// config. Returned function flushes remaining spans and stops the export. If
+// export is disabled, spans are still created, so that trace ids of incoming
+// requests are propagated, but they are not recorded.
+func Init(ctx context.Context, cfg *config.ParsedTracingConfig) (func(context.Context) error, error) {
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.OtlpEndpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(cfg.ServiceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Extract returns context with remote span described by W3C traceparent
+// header of incoming request
+func Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return propagator.Extract(ctx, carrier)
+}
+
+// Inject adds W3C traceparent header of the span in the context to outgoing
+// request
+func Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	propagator.Inject(ctx, carrier)
+}
+
+// Span measures duration of single operation. It is exported as OpenTelemetry
+// span and recorded in tracing info of the request.
+type Span struct {
+	name  string
+	start time.Time
+	span  trace.Span
+	// nil for spans of the whole request
+	info *TracingInfo
+}
+
+func startSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, *Span) {
+	info, _ := ctx.Value(TraceInfoKey).(*TracingInfo)
+	ctx, span := otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+
+	return ctx, &Span{
+		name:  name,
+		start: time.Now(),
+		span:  span,
+		info:  info,
+	}
+}
+
+// StartSpan starts span of the named operation. Returned context must be
+// passed to nested operations, so that their spans are children of this span.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, *Span) {
+	return startSpan(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartRequest starts server span of incoming request, which is child of the
+// remote span extracted from the request. Trace id and tracing info of the
+// request are attached to the returned context.
+func StartRequest(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, *Span) {
+	ctx, span := startSpan(ctx, name, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(attrs...))
+
+	// trace id is random if the request does not continue trace of the
+	// client and traces are not exported
 	traceID := uuid.New().String()
+	if spanContext := span.span.SpanContext(); spanContext.HasTraceID() {
+		traceID = spanContext.TraceID().String()
+	}
 	ctx = context.WithValue(ctx, TraceIdKey, traceID)
 
-	// Start tracingInfo
-	return context.WithValue(ctx, TraceInfoKey, &TracingInfo{})
+	return context.WithValue(ctx, TraceInfoKey, &TracingInfo{}), span
+}
+
+func (s *Span) SetAttributes(attrs ...attribute.KeyValue) {
+	s.span.SetAttributes(attrs...)
+}
+
+// End ends the span, marking it as failed if err is not nil, and returns its
+// duration
+func (s *Span) End(err error) time.Duration {
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+
+	duration := time.Since(s.start)
+	if s.info != nil {
+		s.info.addSpan(SpanDetail{
+			Name:     s.name,
+			Duration: duration.Milliseconds(),
+		})
+	}
+
+	return duration
 }
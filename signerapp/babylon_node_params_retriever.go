@@ -0,0 +1,433 @@
+package signerapp
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/babylonlabs-io/babylon/btcstaking"
+	"github.com/babylonlabs-io/covenant-signer/config"
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/utils"
+	"github.com/babylonlabs-io/networks/parameters/parser"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+const (
+	stakingParamsVersionsPath = "/babylon/btcstaking/v1/params_versions"
+	checkpointParamsPath      = "/babylon/btccheckpoint/v1/params"
+	// staking params responses are small, 1MB is more than enough
+	maxBabylonNodeResponseSize = 1 << 20
+	babylonNodeRequestTimeout  = 30 * time.Second
+)
+
+// babylonStakingParams is the json representation of Babylon node btc staking
+// params as returned by the REST endpoint. 64bit integers are encoded as strings.
+type babylonStakingParams struct {
+	CovenantPks          []string `json:"covenant_pks"`
+	CovenantQuorum       uint32   `json:"covenant_quorum"`
+	MinStakingValueSat   int64    `json:"min_staking_value_sat,string"`
+	MaxStakingValueSat   int64    `json:"max_staking_value_sat,string"`
+	MinStakingTimeBlocks uint32   `json:"min_staking_time_blocks"`
+	MaxStakingTimeBlocks uint32   `json:"max_staking_time_blocks"`
+	UnbondingTimeBlocks  uint32   `json:"unbonding_time_blocks"`
+	UnbondingFeeSat      int64    `json:"unbonding_fee_sat,string"`
+	BtcActivationHeight  uint32   `json:"btc_activation_height"`
+	// bytes are base64 encoded in json
+	SlashingPkScript    []byte `json:"slashing_pk_script"`
+	SlashingRate        string `json:"slashing_rate"`
+	MinSlashingTxFeeSat int64  `json:"min_slashing_tx_fee_sat,string"`
+}
+
+type babylonStoredParams struct {
+	Version uint32               `json:"version"`
+	Params  babylonStakingParams `json:"params"`
+}
+
+type babylonParamsVersionsResponse struct {
+	Params     []babylonStoredParams `json:"params"`
+	Pagination struct {
+		NextKey string `json:"next_key"`
+	} `json:"pagination"`
+}
+
+type babylonCheckpointParamsResponse struct {
+	Params struct {
+		BtcConfirmationDepth uint32 `json:"btc_confirmation_depth"`
+	} `json:"params"`
+}
+
+type babylonVersionedParams struct {
+	version          uint32
+	activationHeight uint32
+	raw              babylonStakingParams
+	params           *BabylonParams
+}
+
+var _ ReloadableParams = &BabylonNodeParamsRetriever{}
+
+// BabylonNodeParamsRetriever retrieves versioned btc staking params from
+// Babylon node. All versions are cached in memory and refreshed in the
+// background, so that signing requests never wait for the node.
+type BabylonNodeParamsRetriever struct {
+	cfg       *config.ParsedParamsConfig
+	client    *http.Client
+	validator *GlobalParamsValidator
+	metrics   *m.CovenantSignerMetrics
+
+	// guards refreshes, so that concurrent refreshes do not race on versions
+	mu sync.Mutex
+	// versions ordered by version number, activation heights are increasing
+	versions atomic.Pointer[[]*babylonVersionedParams]
+}
+
+func NewBabylonNodeParamsRetriever(
+	ctx context.Context,
+	cfg *config.ParsedParamsConfig,
+	client *http.Client,
+	validator *GlobalParamsValidator,
+	metrics *m.CovenantSignerMetrics,
+) (*BabylonNodeParamsRetriever, error) {
+	if cfg.Source != config.ParamsSourceBabylon {
+		return nil, fmt.Errorf("invalid params source %s for babylon node params retriever", cfg.Source)
+	}
+
+	if client == nil {
+		client = &http.Client{Timeout: babylonNodeRequestTimeout}
+	}
+
+	r := &BabylonNodeParamsRetriever{
+		cfg:       cfg,
+		client:    client,
+		validator: validator,
+		metrics:   metrics,
+	}
+
+	if err := r.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *BabylonNodeParamsRetriever) ParamsByHeight(_ context.Context, height uint64) (*BabylonParams, error) {
+	versions := *r.versions.Load()
+
+	for i := len(versions) - 1; i >= 0; i-- {
+		if uint64(versions[i].activationHeight) <= height {
+			params := *versions[i].params
+			return &params, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no global params for height %d", height)
+}
+
+// ParamsVersions returns currently cached versions ordered by version
+func (r *BabylonNodeParamsRetriever) ParamsVersions() []ParamsVersion {
+	versions := *r.versions.Load()
+	res := make([]ParamsVersion, len(versions))
+	for i, v := range versions {
+		res[i] = ParamsVersion{Version: uint64(v.version), ActivationHeight: uint64(v.activationHeight)}
+	}
+	return res
+}
+
+// ReloadParams refreshes params from Babylon node on demand
+func (r *BabylonNodeParamsRetriever) ReloadParams(ctx context.Context) error {
+	return r.Refresh(ctx)
+}
+
+func (r *BabylonNodeParamsRetriever) get(ctx context.Context, path string, query url.Values, result any) error {
+	route := r.cfg.BabylonNodeUrl + path
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, route, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxBabylonNodeResponseSize))
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("babylon node request %s failed. status code: %d, message: %s", path, res.StatusCode, string(body))
+	}
+
+	return json.Unmarshal(body, result)
+}
+
+func (r *BabylonNodeParamsRetriever) fetchStakingParams(ctx context.Context) ([]babylonStoredParams, error) {
+	var all []babylonStoredParams
+	query := url.Values{}
+
+	for {
+		var res babylonParamsVersionsResponse
+		if err := r.get(ctx, stakingParamsVersionsPath, query, &res); err != nil {
+			return nil, err
+		}
+
+		all = append(all, res.Params...)
+
+		if res.Pagination.NextKey == "" {
+			return all, nil
+		}
+		query.Set("pagination.key", res.Pagination.NextKey)
+	}
+}
+
+func (r *BabylonNodeParamsRetriever) toBabylonParams(
+	p *babylonStakingParams,
+	confirmationDepth uint32,
+) (*BabylonParams, error) {
+	if len(p.CovenantPks) == 0 {
+		return nil, fmt.Errorf("empty covenant public keys")
+	}
+
+	if p.CovenantQuorum == 0 || p.CovenantQuorum > uint32(len(p.CovenantPks)) {
+		return nil, fmt.Errorf("invalid covenant quorum %d for %d covenant keys", p.CovenantQuorum, len(p.CovenantPks))
+	}
+
+	covenantKeys := make([]*btcec.PublicKey, 0, len(p.CovenantPks))
+	for _, pkHex := range p.CovenantPks {
+		// Babylon node uses BIP340 x-only covenant keys
+		key, err := utils.PubKeyFromHex(pkHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid covenant public key %s: %w", pkHex, err)
+		}
+		covenantKeys = append(covenantKeys, key)
+	}
+
+	for _, v := range []uint32{
+		p.MinStakingTimeBlocks,
+		p.MaxStakingTimeBlocks,
+		p.UnbondingTimeBlocks,
+	} {
+		if v == 0 || v > math.MaxUint16 {
+			return nil, fmt.Errorf("invalid time lock value %d", v)
+		}
+	}
+
+	if confirmationDepth == 0 || confirmationDepth > math.MaxUint16 {
+		return nil, fmt.Errorf("invalid btc confirmation depth %d", confirmationDepth)
+	}
+
+	if p.MinStakingValueSat <= 0 || p.MaxStakingValueSat < p.MinStakingValueSat {
+		return nil, fmt.Errorf("invalid staking value bounds: min %d, max %d", p.MinStakingValueSat, p.MaxStakingValueSat)
+	}
+
+	if p.UnbondingFeeSat <= 0 || p.UnbondingFeeSat >= p.MinStakingValueSat {
+		return nil, fmt.Errorf("invalid unbonding fee %d", p.UnbondingFeeSat)
+	}
+
+	if len(p.SlashingPkScript) == 0 {
+		return nil, fmt.Errorf("empty slashing pk script")
+	}
+
+	slashingRate, err := sdkmath.LegacyNewDecFromStr(p.SlashingRate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid slashing rate %s: %w", p.SlashingRate, err)
+	}
+
+	if !btcstaking.IsRateValid(slashingRate) {
+		return nil, fmt.Errorf("invalid slashing rate %s", p.SlashingRate)
+	}
+
+	if p.MinSlashingTxFeeSat <= 0 {
+		return nil, fmt.Errorf("invalid min slashing tx fee %d", p.MinSlashingTxFeeSat)
+	}
+
+	//#nosec G115 -- all uint16 conversions are checked above
+	return &BabylonParams{
+		CovenantPublicKeys: covenantKeys,
+		CovenantQuorum:     p.CovenantQuorum,
+		MagicBytes:         r.cfg.StakingTag,
+		UnbondingTime:      uint16(p.UnbondingTimeBlocks),
+		UnbondingFee:       btcutil.Amount(p.UnbondingFeeSat),
+		MaxStakingAmount:   btcutil.Amount(p.MaxStakingValueSat),
+		MinStakingAmount:   btcutil.Amount(p.MinStakingValueSat),
+		MaxStakingTime:     uint16(p.MaxStakingTimeBlocks),
+		MinStakingTime:     uint16(p.MinStakingTimeBlocks),
+		ConfirmationDepth:  uint16(confirmationDepth),
+		SlashingPkScript:   p.SlashingPkScript,
+		SlashingRate:       slashingRate,
+		MinSlashingTxFee:   btcutil.Amount(p.MinSlashingTxFeeSat),
+	}, nil
+}
+
+// globalParamsVersion converts params version to global params file format
+func (v *babylonVersionedParams) globalParamsVersion() *parser.VersionedGlobalParams {
+	covenantPks := make([]string, len(v.params.CovenantPublicKeys))
+	for i, pk := range v.params.CovenantPublicKeys {
+		covenantPks[i] = hex.EncodeToString(pk.SerializeCompressed())
+	}
+
+	return &parser.VersionedGlobalParams{
+		Version:           uint64(v.version),
+		ActivationHeight:  uint64(v.activationHeight),
+		Tag:               hex.EncodeToString(v.params.MagicBytes),
+		CovenantPks:       covenantPks,
+		CovenantQuorum:    uint64(v.params.CovenantQuorum),
+		UnbondingTime:     uint64(v.params.UnbondingTime),
+		UnbondingFee:      uint64(v.params.UnbondingFee),
+		MaxStakingAmount:  uint64(v.params.MaxStakingAmount),
+		MinStakingAmount:  uint64(v.params.MinStakingAmount),
+		MaxStakingTime:    uint64(v.params.MaxStakingTime),
+		MinStakingTime:    uint64(v.params.MinStakingTime),
+		ConfirmationDepth: uint64(v.params.ConfirmationDepth),
+	}
+}
+
+// Refresh retrieves all params versions from Babylon node and replaces cached
+// versions. Already cached versions must not change, as they could be already
+// used to sign transactions. On error, previously cached versions stay active.
+func (r *BabylonNodeParamsRetriever) Refresh(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.refresh(ctx); err != nil {
+		r.metrics.IncGlobalParamsReloads(false)
+		return err
+	}
+
+	return nil
+}
+
+func (r *BabylonNodeParamsRetriever) refresh(ctx context.Context) error {
+	stored, err := r.fetchStakingParams(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve staking params from babylon node: %w", err)
+	}
+
+	if len(stored) == 0 {
+		return fmt.Errorf("babylon node returned no staking params versions")
+	}
+
+	var checkpointParams babylonCheckpointParamsResponse
+	if err := r.get(ctx, checkpointParamsPath, nil, &checkpointParams); err != nil {
+		return fmt.Errorf("failed to retrieve checkpoint params from babylon node: %w", err)
+	}
+
+	versions := make([]*babylonVersionedParams, 0, len(stored))
+	for i, s := range stored {
+		if int(s.Version) != i {
+			return fmt.Errorf("babylon node returned staking params versions out of order, expected version %d, got %d", i, s.Version)
+		}
+
+		if i > 0 && s.Params.BtcActivationHeight <= stored[i-1].Params.BtcActivationHeight {
+			return fmt.Errorf("activation height of staking params version %d is not increasing", s.Version)
+		}
+
+		params, err := r.toBabylonParams(&s.Params, checkpointParams.Params.BtcConfirmationDepth)
+		if err != nil {
+			return fmt.Errorf("invalid staking params version %d: %w", s.Version, err)
+		}
+		params.Version = uint64(s.Version)
+
+		versions = append(versions, &babylonVersionedParams{
+			version:          s.Version,
+			activationHeight: s.Params.BtcActivationHeight,
+			raw:              s.Params,
+			params:           params,
+		})
+	}
+
+	// versions are checked by the same validator as global params file
+	globalParamsVersions := make([]*parser.VersionedGlobalParams, len(versions))
+	for i, v := range versions {
+		globalParamsVersions[i] = v.globalParamsVersion()
+	}
+	if err := r.validator.ValidateVersions(globalParamsVersions); err != nil {
+		return fmt.Errorf("babylon node returned invalid staking params: %w", err)
+	}
+
+	if current := r.versions.Load(); current != nil {
+		if len(versions) < len(*current) {
+			return fmt.Errorf("babylon node returned %d staking params versions, but %d are already loaded", len(versions), len(*current))
+		}
+
+		for i, v := range *current {
+			if !reflect.DeepEqual(v.raw, versions[i].raw) {
+				return fmt.Errorf("babylon node modified already loaded staking params version %d", v.version)
+			}
+
+			// confirmation depth comes from checkpoint params, but it is part
+			// of every loaded version as well
+			if v.params.ConfirmationDepth != versions[i].params.ConfirmationDepth {
+				return fmt.Errorf(
+					"babylon node changed btc confirmation depth of already loaded staking params version %d from %d to %d",
+					v.version,
+					v.params.ConfirmationDepth,
+					versions[i].params.ConfirmationDepth,
+				)
+			}
+		}
+
+		if len(versions) == len(*current) {
+			// nothing changed
+			return nil
+		}
+	}
+
+	r.versions.Store(&versions)
+
+	activationHeights := make(map[uint64]uint64, len(versions))
+	for _, v := range versions {
+		activationHeights[uint64(v.version)] = uint64(v.activationHeight)
+	}
+	r.metrics.SetGlobalParamsVersions(activationHeights)
+	r.metrics.IncGlobalParamsReloads(true)
+
+	latest := versions[len(versions)-1]
+	logging.Logger(logging.ComponentParams).Info().
+		Str("source", r.cfg.BabylonNodeUrl).
+		Int("versions", len(versions)).
+		Uint32("latestVersion", latest.version).
+		Uint32("latestActivationHeight", latest.activationHeight).
+		Msg("global params loaded")
+
+	return nil
+}
+
+// Start refreshes params in configured interval until the context is cancelled
+func (r *BabylonNodeParamsRetriever) Start(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(r.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.Refresh(ctx); err != nil {
+					logging.Logger(logging.ComponentParams).Error().Err(err).Msg("failed to refresh babylon node params, keeping previous params")
+				}
+			}
+		}
+	}()
+
+	return nil
+}
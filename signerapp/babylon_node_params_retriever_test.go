@@ -0,0 +1,226 @@
+package signerapp_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/babylonlabs-io/covenant-signer/config"
+	"github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+)
+
+// babylonNodeStub serves btc staking and checkpoint params in the same format
+// as Babylon node REST endpoints
+type babylonNodeStub struct {
+	*httptest.Server
+	mu                sync.Mutex
+	versions          []map[string]any
+	confirmationDepth uint32
+	requests          atomic.Int32
+}
+
+func newBabylonNodeStub(t *testing.T) *babylonNodeStub {
+	s := &babylonNodeStub{confirmationDepth: 10}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/babylon/btcstaking/v1/params_versions", func(w http.ResponseWriter, _ *http.Request) {
+		s.requests.Add(1)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"params":     s.versions,
+			"pagination": map[string]any{"next_key": nil, "total": fmt.Sprint(len(s.versions))},
+		})
+	})
+	mux.HandleFunc("/babylon/btccheckpoint/v1/params", func(w http.ResponseWriter, _ *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"params": map[string]any{"btc_confirmation_depth": s.confirmationDepth},
+		})
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *babylonNodeStub) addVersion(activationHeight uint32, minStakingTime uint32) {
+	var covenantPks []string
+	for _, pk := range parsed.Versions[0].CovenantPks {
+		covenantPks = append(covenantPks, hex.EncodeToString(schnorr.SerializePubKey(pk)))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.versions = append(s.versions, map[string]any{
+		"version": len(s.versions),
+		"params": map[string]any{
+			"covenant_pks":            covenantPks,
+			"covenant_quorum":         3,
+			"min_staking_value_sat":   "3000",
+			"max_staking_value_sat":   "300000",
+			"min_staking_time_blocks": minStakingTime,
+			"max_staking_time_blocks": 10000,
+			"slashing_rate":           "0.100000000000000000",
+			"slashing_pk_script":      base64.StdEncoding.EncodeToString(slashingPkScript),
+			"min_slashing_tx_fee_sat": "1000",
+			"unbonding_time_blocks":   1000,
+			"unbonding_fee_sat":       "1000",
+			"btc_activation_height":   activationHeight,
+		},
+	})
+}
+
+func TestBabylonNodeParamsRetriever(t *testing.T) {
+	s := newBabylonNodeStub(t)
+	s.addVersion(100, 100)
+
+	cfg := config.DefaultParamsConfig()
+	cfg.Source = string(config.ParamsSourceBabylon)
+	cfg.BabylonNodeUrl = s.URL
+	cfg.StakingTag = "01020304"
+	parsedCfg, err := cfg.Parse()
+	require.NoError(t, err)
+
+	r, err := signerapp.NewBabylonNodeParamsRetriever(
+		context.Background(), parsedCfg, s.Client(), paramsValidator, metrics.NewCovenantSignerMetrics(),
+	)
+	require.NoError(t, err)
+
+	params, err := r.ParamsByHeight(context.Background(), 150)
+	require.NoError(t, err)
+	require.Equal(t, uint16(100), params.MinStakingTime)
+	require.Equal(t, uint16(10), params.ConfirmationDepth)
+	require.Equal(t, []byte{0x01, 0x02, 0x03, 0x04}, params.MagicBytes)
+	require.Len(t, params.CovenantPublicKeys, 5)
+	require.Equal(t, slashingPkScript, params.SlashingPkScript)
+	require.Equal(t, "0.100000000000000000", params.SlashingRate.String())
+	require.Equal(
+		t,
+		schnorr.SerializePubKey(parsed.Versions[0].CovenantPks[0]),
+		schnorr.SerializePubKey(params.CovenantPublicKeys[0]),
+	)
+
+	_, err = r.ParamsByHeight(context.Background(), 99)
+	require.Error(t, err)
+
+	// params are served from cache
+	requests := s.requests.Load()
+	_, err = r.ParamsByHeight(context.Background(), 150)
+	require.NoError(t, err)
+	require.Equal(t, requests, s.requests.Load())
+
+	// new version is picked up on refresh
+	s.addVersion(200, 200)
+	require.NoError(t, r.Refresh(context.Background()))
+
+	params, err = r.ParamsByHeight(context.Background(), 150)
+	require.NoError(t, err)
+	require.Equal(t, uint16(100), params.MinStakingTime)
+
+	params, err = r.ParamsByHeight(context.Background(), 200)
+	require.NoError(t, err)
+	require.Equal(t, uint16(200), params.MinStakingTime)
+
+	// modification of already loaded version is rejected
+	s.mu.Lock()
+	s.versions[0]["params"].(map[string]any)["min_staking_time_blocks"] = 150
+	s.mu.Unlock()
+	require.Error(t, r.Refresh(context.Background()))
+
+	params, err = r.ParamsByHeight(context.Background(), 150)
+	require.NoError(t, err)
+	require.Equal(t, uint16(100), params.MinStakingTime)
+}
+
+func TestBabylonNodeParamsRetrieverRejectsConfirmationDepthChange(t *testing.T) {
+	s := newBabylonNodeStub(t)
+	s.addVersion(100, 100)
+
+	cfg := config.DefaultParamsConfig()
+	cfg.Source = string(config.ParamsSourceBabylon)
+	cfg.BabylonNodeUrl = s.URL
+	cfg.StakingTag = "01020304"
+	parsedCfg, err := cfg.Parse()
+	require.NoError(t, err)
+
+	r, err := signerapp.NewBabylonNodeParamsRetriever(
+		context.Background(), parsedCfg, s.Client(), paramsValidator, metrics.NewCovenantSignerMetrics(),
+	)
+	require.NoError(t, err)
+
+	// change of confirmation depth modifies already loaded version, even if
+	// new version is added at the same time
+	s.mu.Lock()
+	s.confirmationDepth = 20
+	s.mu.Unlock()
+	s.addVersion(200, 200)
+	require.ErrorContains(t, r.Refresh(context.Background()), "confirmation depth")
+
+	params, err := r.ParamsByHeight(context.Background(), 150)
+	require.NoError(t, err)
+	require.Equal(t, uint16(10), params.ConfirmationDepth)
+	params, err = r.ParamsByHeight(context.Background(), 200)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), params.Version)
+
+	// confirmation depth not fitting into uint16 is rejected
+	s.mu.Lock()
+	s.confirmationDepth = 1 << 16
+	s.mu.Unlock()
+	_, err = signerapp.NewBabylonNodeParamsRetriever(
+		context.Background(), parsedCfg, s.Client(), paramsValidator, metrics.NewCovenantSignerMetrics(),
+	)
+	require.ErrorContains(t, err, "invalid btc confirmation depth")
+}
+
+func TestBabylonNodeParamsRetrieverValidatesParams(t *testing.T) {
+	s := newBabylonNodeStub(t)
+	s.addVersion(100, 100)
+
+	cfg := config.DefaultParamsConfig()
+	cfg.Source = string(config.ParamsSourceBabylon)
+	cfg.BabylonNodeUrl = s.URL
+	parsedCfg, err := cfg.Parse()
+	require.NoError(t, err)
+
+	r, err := signerapp.NewBabylonNodeParamsRetriever(
+		context.Background(), parsedCfg, s.Client(), paramsValidator, metrics.NewCovenantSignerMetrics(),
+	)
+	require.NoError(t, err)
+
+	// version with duplicated covenant key is rejected by the validator
+	s.addVersion(200, 200)
+	s.mu.Lock()
+	params := s.versions[1]["params"].(map[string]any)
+	covenantPks := params["covenant_pks"].([]string)
+	params["covenant_pks"] = append(covenantPks, covenantPks[0])
+	s.mu.Unlock()
+
+	var validationErr *signerapp.GlobalParamsValidationError
+	require.ErrorAs(t, r.Refresh(context.Background()), &validationErr)
+	require.Len(t, r.ParamsVersions(), 1)
+
+	// activation height above max staking transaction height is rejected
+	s.mu.Lock()
+	params["covenant_pks"] = covenantPks
+	s.mu.Unlock()
+	_, err = signerapp.NewBabylonNodeParamsRetriever(
+		context.Background(),
+		parsedCfg,
+		s.Client(),
+		signerapp.NewGlobalParamsValidator(&chaincfg.RegressionNetParams, 150),
+		metrics.NewCovenantSignerMetrics(),
+	)
+	require.ErrorAs(t, err, &validationErr)
+}
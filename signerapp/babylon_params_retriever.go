@@ -29,6 +29,7 @@ func (v *VersionedParamsRetriever) ParamsByHeight(ctx context.Context, height ui
 	}
 
 	return &BabylonParams{
+		Version:            versionedParams.Version,
 		CovenantPublicKeys: versionedParams.CovenantPks,
 		CovenantQuorum:     versionedParams.CovenantQuorum,
 		MagicBytes:         versionedParams.Tag,
@@ -0,0 +1,161 @@
+package signerapp
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"golang.org/x/sync/errgroup"
+)
+
+// UnbondingSigningRequest is single request to sign unbonding transaction,
+// with the same arguments as SignUnbondingTransaction
+type UnbondingSigningRequest struct {
+	StakingOutputPkScript []byte
+	UnbondingTx           *wire.MsgTx
+	StakerUnbondingSig    *schnorr.Signature
+	CovenantPublicKey     *btcec.PublicKey
+	Phase2Data            *Phase2StakingData
+}
+
+// UnbondingSigningResult is result of single request from the batch. Exactly
+// one of the fields is set.
+type UnbondingSigningResult struct {
+	Signature *schnorr.Signature
+	Err       error
+}
+
+// SignUnbondingTransactions signs batch of independent unbonding transactions.
+// Requests are processed concurrently, with at most BatchConcurrency requests
+// at a time. Chain lookups shared by requests from the batch, like the best
+// block height or staking transactions spent by several requests, are done only
+// once. Results are returned in the same order as requests.
+// Error is returned only if the batch itself is invalid, failures of single
+// requests are reported in their results.
+func (s *SignerApp) SignUnbondingTransactions(
+	ctx context.Context,
+	requests []*UnbondingSigningRequest,
+) ([]*UnbondingSigningResult, error) {
+	if len(requests) == 0 {
+		return nil, wrapInvalidSigningRequestError(fmt.Errorf("empty batch"))
+	}
+
+	if len(requests) > s.cfg.MaxBatchSize {
+		return nil, wrapInvalidSigningRequestError(fmt.Errorf(
+			"batch has %d requests, max allowed batch size is %d",
+			len(requests),
+			s.cfg.MaxBatchSize,
+		))
+	}
+
+	batchApp := s.newBatchApp()
+
+	results := make([]*UnbondingSigningResult, len(requests))
+
+	var g errgroup.Group
+	g.SetLimit(s.cfg.BatchConcurrency)
+
+	for i, req := range requests {
+		g.Go(func() error {
+			sig, err := batchApp.SignUnbondingTransaction(
+				ctx,
+				req.StakingOutputPkScript,
+				req.UnbondingTx,
+				req.StakerUnbondingSig,
+				req.CovenantPublicKey,
+				req.Phase2Data,
+			)
+			results[i] = &UnbondingSigningResult{Signature: sig, Err: err}
+			// errors are reported per request and must not stop other requests
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+
+	return results, nil
+}
+
+// newBatchApp returns app which shares chain lookups between requests of
+// single batch and is otherwise configured as s
+func (s *SignerApp) newBatchApp() *SignerApp {
+	batchApp := NewSignerApp(s.s, newCachingChainInfo(s.r), s.p, s.cfg, s.net)
+	batchApp.SetFreeze(s.freeze)
+	batchApp.SetPolicy(s.policy)
+	batchApp.committees = s.committees
+	return batchApp
+}
+
+type cachedResult[T any] struct {
+	once  sync.Once
+	value T
+	err   error
+}
+
+func (c *cachedResult[T]) get(fetch func() (T, error)) (T, error) {
+	c.once.Do(func() {
+		c.value, c.err = fetch()
+	})
+	return c.value, c.err
+}
+
+// cachingChainInfo deduplicates chain lookups done while processing single
+// batch of requests. Cached values are never invalidated, so it must not be
+// used for longer than a single batch. Block hashes are never cached, as they
+// are used to detect reorgs right before signing.
+type cachingChainInfo struct {
+	r BtcChainInfo
+
+	bestBlock cachedResult[uint32]
+
+	mu  sync.Mutex
+	txs map[string]*cachedResult[*TxInfo]
+}
+
+var _ BtcChainInfo = (*cachingChainInfo)(nil)
+
+func newCachingChainInfo(r BtcChainInfo) *cachingChainInfo {
+	return &cachingChainInfo{
+		r:   r,
+		txs: make(map[string]*cachedResult[*TxInfo]),
+	}
+}
+
+func cachedEntry[K comparable, T any](mu *sync.Mutex, entries map[K]*cachedResult[T], key K) *cachedResult[T] {
+	mu.Lock()
+	defer mu.Unlock()
+
+	entry, ok := entries[key]
+	if !ok {
+		entry = &cachedResult[T]{}
+		entries[key] = entry
+	}
+
+	return entry
+}
+
+func (c *cachingChainInfo) TxByHash(ctx context.Context, txHash *chainhash.Hash, pkScript []byte) (*TxInfo, error) {
+	key := txHash.String() + hex.EncodeToString(pkScript)
+	return cachedEntry(&c.mu, c.txs, key).get(func() (*TxInfo, error) {
+		return c.r.TxByHash(ctx, txHash, pkScript)
+	})
+}
+
+func (c *cachingChainInfo) BestBlockHeight(ctx context.Context) (uint32, error) {
+	return c.bestBlock.get(func() (uint32, error) {
+		return c.r.BestBlockHeight(ctx)
+	})
+}
+
+func (c *cachingChainInfo) BlockHashByHeight(ctx context.Context, height uint32) (*chainhash.Hash, error) {
+	return c.r.BlockHashByHeight(ctx, height)
+}
+
+func (c *cachingChainInfo) UnspentTxOut(ctx context.Context, outpoint *wire.OutPoint) (*wire.TxOut, error) {
+	return c.r.UnspentTxOut(ctx, outpoint)
+}
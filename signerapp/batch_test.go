@@ -0,0 +1,92 @@
+package signerapp_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignUnbondingTransactionsBatch(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+
+	unknownCovenantMember, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	var requests []*signerapp.UnbondingSigningRequest
+	for i := 0; i < 5; i++ {
+		data := NewValidTestData(t, deps.params)
+		// every staking tx is looked up only once
+		deps.bi.EXPECT().TxByHash(
+			gomock.Any(),
+			&data.UnbondingTx.TxIn[0].PreviousOutPoint.Hash,
+			data.StakingInfo.StakingOutput.PkScript).Return(
+			&signerapp.TxInfo{
+				Tx:                   data.StakingTransaction,
+				TxInclusionHeight:    200,
+				TxInclusionBlockHash: &stakingTxBlockHash,
+			}, nil,
+		).Times(1)
+
+		covenantKey := deps.params.CovenantPublicKeys[0]
+		if i == 2 {
+			covenantKey = unknownCovenantMember.PubKey()
+		}
+
+		requests = append(requests, &signerapp.UnbondingSigningRequest{
+			StakingOutputPkScript: data.StakingInfo.StakingOutput.PkScript,
+			UnbondingTx:           data.UnbondingTx,
+			StakerUnbondingSig:    data.UnbondingTxStakerSig,
+			CovenantPublicKey:     covenantKey,
+		})
+	}
+
+	// lookups shared by all requests are done once for the whole batch
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil).Times(1)
+	// reorg is checked right before signing of every request
+	deps.bi.EXPECT().BlockHashByHeight(gomock.Any(), uint32(200)).Return(&stakingTxBlockHash, nil).MinTimes(4)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(deps.params, nil).AnyTimes()
+	deps.s.EXPECT().RawSignature(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *signerapp.SigningRequest) (*signerapp.SigningResult, error) {
+			// return staker signature, as it does not matter for test correctness
+			for _, r := range requests {
+				if r.UnbondingTx == req.UnbondingTransaction {
+					return &signerapp.SigningResult{Signature: r.StakerUnbondingSig}, nil
+				}
+			}
+			return nil, errors.New("unknown transaction")
+		},
+	).Times(4)
+
+	results, err := signerApp.SignUnbondingTransactions(context.Background(), requests)
+	require.NoError(t, err)
+	require.Len(t, results, len(requests))
+
+	for i, result := range results {
+		if i == 2 {
+			require.Nil(t, result.Signature)
+			require.True(t, errors.Is(result.Err, signerapp.ErrInvalidSigningRequest))
+			continue
+		}
+
+		require.NoError(t, result.Err)
+		require.Equal(t, requests[i].StakerUnbondingSig, result.Signature)
+	}
+}
+
+func TestSignUnbondingTransactionsBatchTooLarge(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+
+	requests := make([]*signerapp.UnbondingSigningRequest, deps.cfg.MaxBatchSize+1)
+	_, err := signerApp.SignUnbondingTransactions(context.Background(), requests)
+	require.True(t, errors.Is(err, signerapp.ErrInvalidSigningRequest))
+
+	_, err = signerApp.SignUnbondingTransactions(context.Background(), nil)
+	require.True(t, errors.Is(err, signerapp.ErrInvalidSigningRequest))
+}
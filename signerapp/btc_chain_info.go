@@ -6,6 +6,7 @@ import (
 
 	"github.com/babylonlabs-io/covenant-signer/btcclient"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 )
 
 var _ BtcChainInfo = (*BitcoindChainInfo)(nil)
@@ -26,15 +27,24 @@ func (b *BitcoindChainInfo) TxByHash(_ context.Context, txHash *chainhash.Hash,
 	}
 
 	if status != btcclient.TxInChain {
-		return nil, fmt.Errorf("tx with hash %s is not in chain", txHash.String())
+		return nil, fmt.Errorf("tx with hash %s: %w", txHash.String(), ErrTxNotInChain)
 	}
 
 	return &TxInfo{
-		Tx:                conf.Tx,
-		TxInclusionHeight: conf.BlockHeight,
+		Tx:                   conf.Tx,
+		TxInclusionHeight:    conf.BlockHeight,
+		TxInclusionBlockHash: conf.BlockHash,
 	}, nil
 }
 
 func (b *BitcoindChainInfo) BestBlockHeight(_ context.Context) (uint32, error) {
 	return b.c.BestBlockHeight()
 }
+
+func (b *BitcoindChainInfo) BlockHashByHeight(_ context.Context, height uint32) (*chainhash.Hash, error) {
+	return b.c.BlockHashAtHeight(height)
+}
+
+func (b *BitcoindChainInfo) UnspentTxOut(_ context.Context, outpoint *wire.OutPoint) (*wire.TxOut, error) {
+	return b.c.UnspentOutput(outpoint)
+}
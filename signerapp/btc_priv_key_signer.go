@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"github.com/babylonlabs-io/babylon/btcstaking"
+	asig "github.com/babylonlabs-io/babylon/crypto/schnorr-adaptor-signature"
 	"github.com/babylonlabs-io/covenant-signer/btcclient"
+	"github.com/btcsuite/btcd/txscript"
 )
 
 // PrivKeySigner is a signer that uses a private key from connected bitcoind node
@@ -52,3 +54,50 @@ func (s *PrivKeySigner) RawSignature(ctx context.Context, request *SigningReques
 		Signature: sig,
 	}, nil
 }
+
+func (s *PrivKeySigner) AdaptorSignatures(ctx context.Context, request *AdaptorSigningRequest) (*AdaptorSigningResult, error) {
+	if len(request.Transaction.TxIn) != 1 {
+		return nil, fmt.Errorf("invalid transaction received for adaptor signing: transaction must have exactly one input")
+	}
+
+	inputFetcher := txscript.NewCannedPrevOutputFetcher(
+		request.FundingOutput.PkScript,
+		request.FundingOutput.Value,
+	)
+
+	sigHash, err := txscript.CalcTapscriptSignaturehash(
+		txscript.NewTxSigHashes(request.Transaction, inputFetcher),
+		txscript.SigHashDefault,
+		request.Transaction,
+		0,
+		inputFetcher,
+		*request.SpendDescription.ScriptLeaf,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate transaction sighash: %w", err)
+	}
+
+	key, err := s.client.DumpPrivateKey(request.CovenantAddress)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve covenant key for signing: %w", err)
+	}
+	// Zero key after signing
+	defer key.Zero()
+
+	signatures := make([]*asig.AdaptorSignature, 0, len(request.EncryptionKeys))
+	for _, encKey := range request.EncryptionKeys {
+		sig, err := asig.EncSign(key, encKey, sigHash)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create adaptor signature: %w", err)
+		}
+
+		signatures = append(signatures, sig)
+	}
+
+	return &AdaptorSigningResult{
+		Signatures: signatures,
+	}, nil
+}
@@ -25,9 +25,9 @@ func NewPsbtSigner(client *btcclient.BtcClient) *PsbtSigner {
 	}
 }
 
-// TODO: Figure out how to sign complex taproot scripts using psbt packets sent
-// to bitcoind. It may require using descriptors wallets.
-func (s *PsbtSigner) RawSignature(ctx context.Context, request *SigningRequest) (*SigningResult, error) {
+// newUnbondingPsbtPacket builds PSBT packet with unbonding transaction spending
+// the staking output using the spend path from the signing request
+func newUnbondingPsbtPacket(request *SigningRequest) (*psbt.Packet, error) {
 	if err := staking.IsSimpleTransfer(request.UnbondingTransaction); err != nil {
 		return nil, fmt.Errorf("invalid unbonding transaction: %w", err)
 	}
@@ -66,6 +66,18 @@ func (s *PsbtSigner) RawSignature(ctx context.Context, request *SigningRequest)
 		},
 	}
 
+	return psbtPacket, nil
+}
+
+// TODO: Figure out how to sign complex taproot scripts using psbt packets sent
+// to bitcoind. It may require using descriptors wallets.
+func (s *PsbtSigner) RawSignature(ctx context.Context, request *SigningRequest) (*SigningResult, error) {
+	psbtPacket, err := newUnbondingPsbtPacket(request)
+
+	if err != nil {
+		return nil, err
+	}
+
 	signedPacket, err := s.client.SignPsbt(psbtPacket)
 
 	if err != nil {
@@ -93,3 +105,9 @@ func (s *PsbtSigner) RawSignature(ctx context.Context, request *SigningRequest)
 
 	return result, nil
 }
+
+// AdaptorSignatures is not supported, as bitcoind wallet is not able to create
+// adaptor signatures from PSBT packets.
+func (s *PsbtSigner) AdaptorSignatures(ctx context.Context, request *AdaptorSigningRequest) (*AdaptorSigningResult, error) {
+	return nil, fmt.Errorf("adaptor signatures are not supported by psbt signer")
+}
@@ -3,6 +3,8 @@ package signerapp
 import (
 	"context"
 
+	sdkmath "cosmossdk.io/math"
+	asig "github.com/babylonlabs-io/babylon/crypto/schnorr-adaptor-signature"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
@@ -12,6 +14,8 @@ import (
 )
 
 type BabylonParams struct {
+	// Version of the params, params versions are numbered from 0
+	Version            uint64
 	CovenantPublicKeys []*btcec.PublicKey
 	CovenantQuorum     uint32
 	MagicBytes         []byte
@@ -22,6 +26,12 @@ type BabylonParams struct {
 	MaxStakingTime     uint16
 	MinStakingTime     uint16
 	ConfirmationDepth  uint16
+	// Slashing params are available only in params retrieved from Babylon node,
+	// phase-1 global params do not define them. SlashingPkScript is empty if
+	// slashing params are not available.
+	SlashingPkScript []byte
+	SlashingRate     sdkmath.LegacyDec
+	MinSlashingTxFee btcutil.Amount
 }
 
 type BabylonParamsRetriever interface {
@@ -30,16 +40,23 @@ type BabylonParamsRetriever interface {
 }
 
 type TxInfo struct {
-	Tx                *wire.MsgTx
-	TxInclusionHeight uint32
+	Tx                   *wire.MsgTx
+	TxInclusionHeight    uint32
+	TxInclusionBlockHash *chainhash.Hash
 }
 
 type BtcChainInfo interface {
 	// Returns only transactions inluded in canonical chain
 	// passing pkScript as argument make it light client friendly
+	// Returns ErrTxNotInChain if transaction is not included in canonical chain
 	TxByHash(ctx context.Context, txHash *chainhash.Hash, pkScript []byte) (*TxInfo, error)
 
 	BestBlockHeight(ctx context.Context) (uint32, error)
+	// Returns hash of the block at given height in the current best chain
+	BlockHashByHeight(ctx context.Context, height uint32) (*chainhash.Hash, error)
+	// Returns unspent output of transaction included in canonical chain, or
+	// nil if the output does not exist or is already spent
+	UnspentTxOut(ctx context.Context, outpoint *wire.OutPoint) (*wire.TxOut, error)
 }
 
 type SpendPathDescription struct {
@@ -59,6 +76,23 @@ type SigningResult struct {
 	Signature *schnorr.Signature
 }
 
+// AdaptorSigningRequest is request to create adaptor signatures over the
+// transaction, one for each of the encryption keys
+type AdaptorSigningRequest struct {
+	FundingOutput     *wire.TxOut
+	Transaction       *wire.MsgTx
+	CovenantPublicKey *btcec.PublicKey
+	CovenantAddress   btcutil.Address
+	SpendDescription  *SpendPathDescription
+	EncryptionKeys    []*asig.EncryptionKey
+}
+
+type AdaptorSigningResult struct {
+	// Signatures are in the same order as encryption keys in the request
+	Signatures []*asig.AdaptorSignature
+}
+
 type ExternalBtcSigner interface {
 	RawSignature(ctx context.Context, request *SigningRequest) (*SigningResult, error)
+	AdaptorSignatures(ctx context.Context, request *AdaptorSigningRequest) (*AdaptorSigningResult, error)
 }
@@ -0,0 +1,174 @@
+package signerapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/fsnotify/fsnotify"
+)
+
+// ErrSigningFrozen is returned instead of signature while signing is frozen.
+// Request can be retried once the freeze is cleared.
+var ErrSigningFrozen = fmt.Errorf("signing is frozen")
+
+// Freeze is kill switch which stops all signing without stopping the process,
+// so that health endpoints and logs stay available. Signing is frozen while
+// the sentinel file exists, which keeps the freeze across restarts until the
+// file is removed or the freeze is cleared with Unfreeze.
+type Freeze struct {
+	path    string
+	metrics *m.CovenantSignerMetrics
+
+	// serializes changes of the sentinel file
+	mu     sync.Mutex
+	frozen atomic.Bool
+}
+
+// NewFreeze returns freeze which is active if the sentinel file at path
+// exists
+func NewFreeze(path string, metrics *m.CovenantSignerMetrics) (*Freeze, error) {
+	f := &Freeze{
+		path:    filepath.Clean(path),
+		metrics: metrics,
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.sync(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Frozen returns true if signing is frozen. Nil freeze is never frozen.
+func (f *Freeze) Frozen() bool {
+	return f != nil && f.frozen.Load()
+}
+
+// set changes the state and logs the change. Must be called with mu held.
+func (f *Freeze) set(frozen bool, reason string) {
+	if f.frozen.Swap(frozen) == frozen {
+		return
+	}
+
+	f.metrics.SetSigningFrozen(frozen)
+	if frozen {
+		logging.Logger(logging.ComponentServer).Warn().Str("reason", reason).Str("sentinel_file", f.path).Msg("signing frozen")
+	} else {
+		logging.Logger(logging.ComponentServer).Warn().Str("reason", reason).Str("sentinel_file", f.path).Msg("signing unfrozen")
+	}
+}
+
+// sync sets the state from the sentinel file. Must be called with mu held.
+func (f *Freeze) sync() error {
+	_, err := os.Stat(f.path)
+
+	switch {
+	case err == nil:
+		f.set(true, "sentinel file exists")
+	case errors.Is(err, os.ErrNotExist):
+		f.set(false, "sentinel file does not exist")
+	default:
+		// state is unknown, it is safer to not sign
+		f.set(true, "sentinel file cannot be read")
+		return fmt.Errorf("failed to check freeze sentinel file %s: %w", f.path, err)
+	}
+
+	return nil
+}
+
+// Freeze stops signing immediately and creates the sentinel file, so that
+// signing stays frozen after restart. Signing is frozen even if the sentinel
+// file cannot be created.
+func (f *Freeze) Freeze(reason string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.set(true, reason)
+
+	if _, err := os.Stat(f.path); err == nil {
+		// keep reason of the first freeze
+		return nil
+	}
+
+	content := fmt.Sprintf("signing frozen at %s: %s\n", time.Now().UTC().Format(time.RFC3339), reason)
+	if err := os.WriteFile(f.path, []byte(content), 0o600); err != nil {
+		return fmt.Errorf("signing is frozen, but freeze sentinel file %s cannot be created: %w", f.path, err)
+	}
+
+	return nil
+}
+
+// Unfreeze removes the sentinel file and resumes signing. Signing stays frozen
+// if the sentinel file cannot be removed.
+func (f *Freeze) Unfreeze(reason string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := os.Remove(f.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove freeze sentinel file %s: %w", f.path, err)
+	}
+
+	f.set(false, reason)
+
+	return nil
+}
+
+// Start follows creation and removal of the sentinel file until the context
+// is cancelled. The parent directory is watched, as the file does not exist
+// while signing is not frozen.
+func (f *Freeze) Start(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create freeze sentinel file watcher: %w", err)
+	}
+
+	if err := watcher.Add(filepath.Dir(f.path)); err != nil {
+		_ = watcher.Close()
+		return fmt.Errorf("failed to watch freeze sentinel file %s: %w", f.path, err)
+	}
+
+	go func() {
+		defer watcher.Close()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+
+				if filepath.Clean(event.Name) != f.path {
+					continue
+				}
+
+				f.mu.Lock()
+				err := f.sync()
+				f.mu.Unlock()
+
+				if err != nil {
+					logging.Logger(logging.ComponentServer).Error().Err(err).Msg("failed to check freeze sentinel file, signing is frozen")
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logging.Logger(logging.ComponentServer).Error().Err(err).Msg("freeze sentinel file watcher error")
+			}
+		}
+	}()
+
+	return nil
+}
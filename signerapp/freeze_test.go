@@ -0,0 +1,99 @@
+package signerapp_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrozenSignerAppDoesNotSign(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData := NewValidTestData(t, deps.params)
+
+	freeze, err := signerapp.NewFreeze(filepath.Join(t.TempDir(), "signing.frozen"), m.NewCovenantSignerMetrics())
+	require.NoError(t, err)
+	signerApp.SetFreeze(freeze)
+	require.NoError(t, freeze.Freeze("test"))
+
+	deps.bi.EXPECT().TxByHash(
+		gomock.Any(),
+		&validData.UnbondingTx.TxIn[0].PreviousOutPoint.Hash,
+		validData.StakingInfo.StakingOutput.PkScript).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	)
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(deps.params, nil)
+	deps.bi.EXPECT().BlockHashByHeight(gomock.Any(), uint32(200)).Return(&stakingTxBlockHash, nil)
+	// the external signer must not be called while frozen
+
+	_, err = signerApp.SignUnbondingTransaction(
+		context.Background(),
+		validData.StakingInfo.StakingOutput.PkScript,
+		validData.UnbondingTx,
+		validData.UnbondingTxStakerSig,
+		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+	require.ErrorIs(t, err, signerapp.ErrSigningFrozen)
+	_, ok := signerapp.RejectionReason(err)
+	require.False(t, ok)
+}
+
+func TestFreezeIsPersisted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "signing.frozen")
+	metrics := m.NewCovenantSignerMetrics()
+
+	freeze, err := signerapp.NewFreeze(path, metrics)
+	require.NoError(t, err)
+	require.False(t, freeze.Frozen())
+
+	require.NoError(t, freeze.Freeze("suspected compromise"))
+	require.True(t, freeze.Frozen())
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.SigningFrozen))
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(content), "suspected compromise")
+
+	// freeze stays active after restart
+	restarted, err := signerapp.NewFreeze(path, m.NewCovenantSignerMetrics())
+	require.NoError(t, err)
+	require.True(t, restarted.Frozen())
+
+	require.NoError(t, restarted.Unfreeze("test"))
+	require.False(t, restarted.Frozen())
+	require.NoFileExists(t, path)
+
+	restarted, err = signerapp.NewFreeze(path, m.NewCovenantSignerMetrics())
+	require.NoError(t, err)
+	require.False(t, restarted.Frozen())
+}
+
+func TestFreezeFollowsSentinelFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "signing.frozen")
+
+	freeze, err := signerapp.NewFreeze(path, m.NewCovenantSignerMetrics())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, freeze.Start(ctx))
+
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+	require.Eventually(t, freeze.Frozen, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, os.Remove(path))
+	require.Eventually(t, func() bool { return !freeze.Frozen() }, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,114 @@
+package signerapp
+
+import (
+	"context"
+	"time"
+
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/observability/tracing"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// observe starts span of the operation. Returned function ends the span and
+// passes its duration to the metric.
+func observe(ctx context.Context, name string, metric func(d time.Duration)) (context.Context, func(err error)) {
+	ctx, span := tracing.StartSpan(ctx, name)
+	return ctx, func(err error) {
+		metric(span.End(err))
+	}
+}
+
+type instrumentedBtcChainInfo struct {
+	r       BtcChainInfo
+	metrics *m.CovenantSignerMetrics
+}
+
+// NewInstrumentedBtcChainInfo returns BtcChainInfo recording duration of
+// lookups in metrics and in tracing info of the request
+func NewInstrumentedBtcChainInfo(r BtcChainInfo, metrics *m.CovenantSignerMetrics) BtcChainInfo {
+	return &instrumentedBtcChainInfo{r: r, metrics: metrics}
+}
+
+func (i *instrumentedBtcChainInfo) observe(ctx context.Context, method string) (context.Context, func(err error)) {
+	return observe(ctx, "btc_chain_"+method, func(d time.Duration) {
+		i.metrics.ObserveBtcChainLookupDuration(method, d)
+	})
+}
+
+func (i *instrumentedBtcChainInfo) TxByHash(ctx context.Context, txHash *chainhash.Hash, pkScript []byte) (*TxInfo, error) {
+	ctx, end := i.observe(ctx, "tx_by_hash")
+	result, err := i.r.TxByHash(ctx, txHash, pkScript)
+	end(err)
+	return result, err
+}
+
+func (i *instrumentedBtcChainInfo) BestBlockHeight(ctx context.Context) (uint32, error) {
+	ctx, end := i.observe(ctx, "best_block_height")
+	result, err := i.r.BestBlockHeight(ctx)
+	end(err)
+	return result, err
+}
+
+func (i *instrumentedBtcChainInfo) BlockHashByHeight(ctx context.Context, height uint32) (*chainhash.Hash, error) {
+	ctx, end := i.observe(ctx, "block_hash_by_height")
+	result, err := i.r.BlockHashByHeight(ctx, height)
+	end(err)
+	return result, err
+}
+
+func (i *instrumentedBtcChainInfo) UnspentTxOut(ctx context.Context, outpoint *wire.OutPoint) (*wire.TxOut, error) {
+	ctx, end := i.observe(ctx, "unspent_tx_out")
+	result, err := i.r.UnspentTxOut(ctx, outpoint)
+	end(err)
+	return result, err
+}
+
+type instrumentedParamsRetriever struct {
+	p       BabylonParamsRetriever
+	metrics *m.CovenantSignerMetrics
+}
+
+// NewInstrumentedParamsRetriever returns BabylonParamsRetriever recording
+// duration of params retrieval in metrics and in tracing info of the request
+func NewInstrumentedParamsRetriever(p BabylonParamsRetriever, metrics *m.CovenantSignerMetrics) BabylonParamsRetriever {
+	return &instrumentedParamsRetriever{p: p, metrics: metrics}
+}
+
+func (i *instrumentedParamsRetriever) ParamsByHeight(ctx context.Context, height uint64) (*BabylonParams, error) {
+	ctx, end := observe(ctx, "params_by_height", i.metrics.ObserveParamsRetrievalDuration)
+	result, err := i.p.ParamsByHeight(ctx, height)
+	end(err)
+	return result, err
+}
+
+type instrumentedBtcSigner struct {
+	s       ExternalBtcSigner
+	metrics *m.CovenantSignerMetrics
+}
+
+// NewInstrumentedBtcSigner returns ExternalBtcSigner recording duration of
+// signing in metrics and in tracing info of the request
+func NewInstrumentedBtcSigner(s ExternalBtcSigner, metrics *m.CovenantSignerMetrics) ExternalBtcSigner {
+	return &instrumentedBtcSigner{s: s, metrics: metrics}
+}
+
+func (i *instrumentedBtcSigner) observe(ctx context.Context, method string) (context.Context, func(err error)) {
+	return observe(ctx, "btc_signer_"+method, func(d time.Duration) {
+		i.metrics.ObserveBtcSignerDuration(method, d)
+	})
+}
+
+func (i *instrumentedBtcSigner) RawSignature(ctx context.Context, request *SigningRequest) (*SigningResult, error) {
+	ctx, end := i.observe(ctx, "raw_signature")
+	result, err := i.s.RawSignature(ctx, request)
+	end(err)
+	return result, err
+}
+
+func (i *instrumentedBtcSigner) AdaptorSignatures(ctx context.Context, request *AdaptorSigningRequest) (*AdaptorSigningResult, error) {
+	ctx, end := i.observe(ctx, "adaptor_signatures")
+	result, err := i.s.AdaptorSignatures(ctx, request)
+	end(err)
+	return result, err
+}
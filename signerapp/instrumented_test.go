@@ -0,0 +1,121 @@
+package signerapp_test
+
+import (
+	"context"
+	"testing"
+
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/observability/tracing"
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// histogramSampleCount returns number of observations of the histogram with
+// the name, summed over all label values
+func histogramSampleCount(t *testing.T, metrics *m.CovenantSignerMetrics, name string) uint64 {
+	families, err := metrics.Registry.Gather()
+	require.NoError(t, err)
+
+	var count uint64
+	for _, f := range families {
+		if f.GetName() != name {
+			continue
+		}
+		for _, metric := range f.GetMetric() {
+			count += metric.GetHistogram().GetSampleCount()
+		}
+	}
+
+	return count
+}
+
+func TestInstrumentedDependenciesRecordDurations(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	metrics := m.NewCovenantSignerMetrics()
+	signerApp := signerapp.NewSignerApp(
+		signerapp.NewInstrumentedBtcSigner(deps.s, metrics),
+		signerapp.NewInstrumentedBtcChainInfo(deps.bi, metrics),
+		signerapp.NewInstrumentedParamsRetriever(deps.pr, metrics),
+		deps.cfg,
+		&net,
+	)
+	validData := NewValidTestData(t, deps.params)
+
+	deps.bi.EXPECT().TxByHash(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	)
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(deps.params, nil)
+	deps.bi.EXPECT().BlockHashByHeight(gomock.Any(), uint32(200)).Return(&stakingTxBlockHash, nil)
+	deps.s.EXPECT().RawSignature(gomock.Any(), gomock.Any()).Return(&signerapp.SigningResult{
+		Signature: validData.UnbondingTxStakerSig,
+	}, nil)
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	ctx, request := tracing.StartRequest(context.Background(), "test")
+	_, err := signerApp.SignUnbondingTransaction(
+		ctx,
+		validData.StakingInfo.StakingOutput.PkScript,
+		validData.UnbondingTx,
+		validData.UnbondingTxStakerSig,
+		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+	require.NoError(t, err)
+
+	var spans []string
+	for _, span := range ctx.Value(tracing.TraceInfoKey).(*tracing.TracingInfo).SpanDetails {
+		spans = append(spans, span.Name)
+	}
+	// spans are recorded when they end, so nested spans precede their parents
+	require.Equal(t, []string{
+		"btc_chain_tx_by_hash",
+		"btc_chain_best_block_height",
+		"params_by_height",
+		"validate_staking_tx",
+		"validate_unbonding_tx",
+		"verify_staker_signature",
+		"btc_chain_block_hash_by_height",
+		"check_staking_tx_block",
+		"validate_unbonding_request",
+		"btc_signer_raw_signature",
+		"sign_unbonding_tx",
+	}, spans)
+
+	// exported spans form single trace with validation steps nested in the
+	// request
+	request.End(nil)
+	parents := make(map[string]string)
+	names := make(map[trace.SpanID]string)
+	for _, span := range exporter.GetSpans() {
+		names[span.SpanContext.SpanID()] = span.Name
+	}
+	for _, span := range exporter.GetSpans() {
+		parents[span.Name] = names[span.Parent.SpanID()]
+	}
+	require.Equal(t, "test", parents["validate_unbonding_request"])
+	require.Equal(t, "validate_unbonding_request", parents["validate_unbonding_tx"])
+	require.Equal(t, "validate_unbonding_tx", parents["validate_staking_tx"])
+	require.Equal(t, "validate_staking_tx", parents["btc_chain_tx_by_hash"])
+	require.Equal(t, "validate_staking_tx", parents["params_by_height"])
+	require.Equal(t, "check_staking_tx_block", parents["btc_chain_block_hash_by_height"])
+	require.Equal(t, "sign_unbonding_tx", parents["btc_signer_raw_signature"])
+
+	require.Equal(t, uint64(3), histogramSampleCount(t, metrics, "signer_btc_chain_lookup_duration_seconds"))
+	require.Equal(t, uint64(1), histogramSampleCount(t, metrics, "signer_params_retrieval_duration_seconds"))
+	require.Equal(t, uint64(1), histogramSampleCount(t, metrics, "signer_btc_signer_duration_seconds"))
+}
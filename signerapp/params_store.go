@@ -0,0 +1,140 @@
+package signerapp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/networks/parameters/parser"
+)
+
+// ParamsVersion identifies one version of global params
+type ParamsVersion struct {
+	Version          uint64
+	ActivationHeight uint64
+}
+
+// ReloadableParams is params retriever whose params can be listed and
+// reloaded on demand, in addition to the reloads done in the background
+type ReloadableParams interface {
+	BabylonParamsRetriever
+	// ParamsVersions returns currently loaded versions ordered by version
+	ParamsVersions() []ParamsVersion
+	// ReloadParams loads params from their source. On error, previously
+	// loaded params stay active.
+	ReloadParams(ctx context.Context) error
+}
+
+// paramsStore holds currently active global params and allows to atomically
+// swap them for new ones. It is shared by all retrievers which can update
+// params at runtime.
+type paramsStore struct {
+	metrics   *m.CovenantSignerMetrics
+	validator *GlobalParamsValidator
+
+	// guards updates, so that concurrent updates do not race on currentBytes
+	mu           sync.Mutex
+	currentBytes []byte
+	current      atomic.Pointer[VersionedParamsRetriever]
+}
+
+func newParamsStore(validator *GlobalParamsValidator, metrics *m.CovenantSignerMetrics) *paramsStore {
+	return &paramsStore{
+		metrics:   metrics,
+		validator: validator,
+	}
+}
+
+func (s *paramsStore) ParamsByHeight(ctx context.Context, height uint64) (*BabylonParams, error) {
+	return s.current.Load().ParamsByHeight(ctx, height)
+}
+
+// GlobalParams returns currently active global params
+func (s *paramsStore) GlobalParams() *parser.ParsedGlobalParams {
+	return s.current.Load().ParsedGlobalParams
+}
+
+// ParamsVersions returns currently loaded versions ordered by version
+func (s *paramsStore) ParamsVersions() []ParamsVersion {
+	versions := s.GlobalParams().Versions
+	res := make([]ParamsVersion, len(versions))
+	for i, v := range versions {
+		res[i] = ParamsVersion{Version: v.Version, ActivationHeight: v.ActivationHeight}
+	}
+	return res
+}
+
+// update validates and parses params from the provided data and swaps active
+// params if they are valid. On error, previously loaded params stay active.
+// Returns true if active params were changed.
+func (s *paramsStore) update(source string, data []byte) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.currentBytes != nil && bytes.Equal(data, s.currentBytes) {
+		// nothing changed
+		return false, nil
+	}
+
+	newParams, err := s.validator.Validate(data)
+	if err != nil {
+		return false, s.updateFailed(fmt.Errorf("invalid global params from %s: %w", source, err))
+	}
+
+	if current := s.current.Load(); current != nil {
+		if err := checkLoadedVersionsUnchanged(current.ParsedGlobalParams, newParams); err != nil {
+			return false, s.updateFailed(err)
+		}
+	}
+
+	s.current.Store(&VersionedParamsRetriever{newParams})
+	s.currentBytes = data
+
+	activationHeights := make(map[uint64]uint64, len(newParams.Versions))
+	for _, v := range newParams.Versions {
+		activationHeights[v.Version] = v.ActivationHeight
+	}
+	s.metrics.SetGlobalParamsVersions(activationHeights)
+	s.metrics.IncGlobalParamsReloads(true)
+
+	latest := newParams.Versions[len(newParams.Versions)-1]
+	logging.Logger(logging.ComponentParams).Info().
+		Str("source", source).
+		Int("versions", len(newParams.Versions)).
+		Uint64("latestVersion", latest.Version).
+		Uint64("latestActivationHeight", latest.ActivationHeight).
+		Msg("global params loaded")
+
+	return true, nil
+}
+
+func (s *paramsStore) updateFailed(err error) error {
+	s.metrics.IncGlobalParamsReloads(false)
+	return err
+}
+
+// checkLoadedVersionsUnchanged makes sure that the new params only append new
+// versions. Versions which were already loaded could already be used to sign
+// transactions, so changing them at runtime is not allowed.
+func checkLoadedVersionsUnchanged(current, next *parser.ParsedGlobalParams) error {
+	if len(next.Versions) < len(current.Versions) {
+		return fmt.Errorf(
+			"new global params have %d versions, but %d versions are already loaded",
+			len(next.Versions),
+			len(current.Versions),
+		)
+	}
+
+	for i, v := range current.Versions {
+		if !reflect.DeepEqual(v, next.Versions[i]) {
+			return fmt.Errorf("new global params modify already loaded version %d", v.Version)
+		}
+	}
+
+	return nil
+}
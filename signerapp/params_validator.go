@@ -0,0 +1,222 @@
+package signerapp
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/babylonlabs-io/babylon/btcstaking"
+	"github.com/babylonlabs-io/networks/parameters/parser"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// GlobalParamsValidationError lists all problems found in global params
+type GlobalParamsValidationError struct {
+	Problems []string
+}
+
+func (e *GlobalParamsValidationError) Error() string {
+	return fmt.Sprintf(
+		"invalid global params, found %d problem(s):\n- %s",
+		len(e.Problems),
+		strings.Join(e.Problems, "\n- "),
+	)
+}
+
+// GlobalParamsValidator checks that global params are consistent with each other
+// and with signer configuration. Contrary to the params parser, which stops on
+// the first error, it reports all found problems.
+type GlobalParamsValidator struct {
+	net                         *chaincfg.Params
+	maxStakingTransactionHeight uint32
+}
+
+func NewGlobalParamsValidator(net *chaincfg.Params, maxStakingTransactionHeight uint32) *GlobalParamsValidator {
+	return &GlobalParamsValidator{
+		net:                         net,
+		maxStakingTransactionHeight: maxStakingTransactionHeight,
+	}
+}
+
+// Validate checks raw global params file and returns parsed params if there
+// are no problems
+func (v *GlobalParamsValidator) Validate(data []byte) (*parser.ParsedGlobalParams, error) {
+	var globalParams parser.GlobalParams
+	if err := json.Unmarshal(data, &globalParams); err != nil {
+		return nil, &GlobalParamsValidationError{
+			Problems: []string{fmt.Sprintf("invalid json: %s", err)},
+		}
+	}
+
+	problems := v.check(&globalParams)
+	if len(problems) > 0 {
+		return nil, &GlobalParamsValidationError{Problems: problems}
+	}
+
+	// parser performs all other checks of single values
+	parsed, err := parser.ParseGlobalParams(&globalParams)
+	if err != nil {
+		return nil, &GlobalParamsValidationError{Problems: []string{err.Error()}}
+	}
+
+	return parsed, nil
+}
+
+// ValidateVersions checks params versions retrieved from other source than
+// global params file, e.g. from Babylon node, which are already converted to
+// global params format
+func (v *GlobalParamsValidator) ValidateVersions(versions []*parser.VersionedGlobalParams) error {
+	problems := v.check(&parser.GlobalParams{Versions: versions})
+	if len(problems) > 0 {
+		return &GlobalParamsValidationError{Problems: problems}
+	}
+
+	return nil
+}
+
+func (v *GlobalParamsValidator) check(p *parser.GlobalParams) []string {
+	var problems []string
+	addProblem := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if len(p.Versions) == 0 {
+		addProblem("global params must have at least one version")
+		return problems
+	}
+
+	if p.Versions[0].ActivationHeight > uint64(v.maxStakingTransactionHeight) {
+		addProblem(
+			"first version activation height %d is above max-staking-transaction-height %d, no staking transaction could be signed",
+			p.Versions[0].ActivationHeight,
+			v.maxStakingTransactionHeight,
+		)
+	}
+
+	for i, version := range p.Versions {
+		if i > 0 {
+			prev := p.Versions[i-1]
+
+			if version.Version != prev.Version+1 {
+				addProblem("version %d follows version %d, versions must increase by 1", version.Version, prev.Version)
+			}
+
+			if version.ActivationHeight <= prev.ActivationHeight {
+				addProblem(
+					"version %d activation height %d is not higher than version %d activation height %d",
+					version.Version, version.ActivationHeight, prev.Version, prev.ActivationHeight,
+				)
+			}
+
+			if version.ActivationHeight > uint64(v.maxStakingTransactionHeight) {
+				addProblem(
+					"version %d activation height %d is above max-staking-transaction-height %d, it would never be used",
+					version.Version, version.ActivationHeight, v.maxStakingTransactionHeight,
+				)
+			}
+		}
+
+		if version.UnbondingFee >= version.MinStakingAmount {
+			addProblem(
+				"version %d unbonding fee %d must be lower than min staking amount %d",
+				version.Version, version.UnbondingFee, version.MinStakingAmount,
+			)
+		}
+
+		if version.CovenantQuorum == 0 || version.CovenantQuorum > uint64(len(version.CovenantPks)) {
+			addProblem(
+				"version %d covenant quorum %d must be between 1 and number of covenant keys %d",
+				version.Version, version.CovenantQuorum, len(version.CovenantPks),
+			)
+		}
+
+		covenantKeys, keyProblems := v.parseCovenantKeys(version)
+		for _, keyProblem := range keyProblems {
+			addProblem("version %d %s", version.Version, keyProblem)
+		}
+
+		if len(keyProblems) == 0 && len(covenantKeys) > 0 {
+			if err := v.checkStakingScripts(version, covenantKeys); err != nil {
+				addProblem("version %d cannot be used to build staking output: %s", version.Version, err)
+			}
+		}
+	}
+
+	return problems
+}
+
+func (v *GlobalParamsValidator) parseCovenantKeys(version *parser.VersionedGlobalParams) ([]*btcec.PublicKey, []string) {
+	var (
+		keys     []*btcec.PublicKey
+		problems []string
+	)
+	seen := make(map[string]struct{}, len(version.CovenantPks))
+
+	for _, keyHex := range version.CovenantPks {
+		keyBytes, err := hex.DecodeString(keyHex)
+		if err != nil || len(keyBytes) != btcec.PubKeyBytesLenCompressed {
+			problems = append(problems, fmt.Sprintf("covenant key %s is not hex encoded 33 byte compressed public key", keyHex))
+			continue
+		}
+
+		key, err := btcec.ParsePubKey(keyBytes)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("covenant key %s is invalid: %s", keyHex, err))
+			continue
+		}
+
+		// keys are used as x-only keys in staking scripts, so keys which differ
+		// only in parity are duplicates as well
+		xOnly := hex.EncodeToString(schnorr.SerializePubKey(key))
+		if _, ok := seen[xOnly]; ok {
+			problems = append(problems, fmt.Sprintf("covenant key %s is duplicated", keyHex))
+			continue
+		}
+		seen[xOnly] = struct{}{}
+
+		keys = append(keys, key)
+	}
+
+	return keys, problems
+}
+
+// checkStakingScripts builds staking output from the version covenant keys,
+// quorum and min staking time, to make sure that staking scripts can be built
+// from the params in practice. Staking scripts do not depend on the network.
+func (v *GlobalParamsValidator) checkStakingScripts(
+	version *parser.VersionedGlobalParams,
+	covenantKeys []*btcec.PublicKey,
+) error {
+	if version.CovenantQuorum == 0 || version.CovenantQuorum > uint64(len(covenantKeys)) ||
+		version.MinStakingTime == 0 || version.MinStakingTime > uint64(^uint16(0)) {
+		// already reported by other checks
+		return nil
+	}
+
+	stakerKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return err
+	}
+
+	fpKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return err
+	}
+
+	//#nosec G115 -- bounds are checked above
+	_, err = btcstaking.BuildStakingInfo(
+		stakerKey.PubKey(),
+		[]*btcec.PublicKey{fpKey.PubKey()},
+		covenantKeys,
+		uint32(version.CovenantQuorum),
+		uint16(version.MinStakingTime),
+		btcutil.Amount(version.MinStakingAmount),
+		v.net,
+	)
+
+	return err
+}
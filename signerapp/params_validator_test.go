@@ -0,0 +1,48 @@
+package signerapp_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/babylonlabs-io/networks/parameters/parser"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGlobalParams(t *testing.T) {
+	data, err := json.Marshal(paramsWithNextVersion(200))
+	require.NoError(t, err)
+
+	parsedParams, err := paramsValidator.Validate(data)
+	require.NoError(t, err)
+	require.Len(t, parsedParams.Versions, 2)
+
+	// second version would never be used with such max staking tx height
+	_, err = signerapp.NewGlobalParamsValidator(&net, 199).Validate(data)
+	require.Error(t, err)
+}
+
+func TestValidateGlobalParamsReportsAllProblems(t *testing.T) {
+	invalid := defaultParam
+	invalid.CovenantPks = append([]string{}, defaultParam.CovenantPks...)
+	invalid.CovenantPks = append(invalid.CovenantPks, defaultParam.CovenantPks[0])
+	invalid.CovenantQuorum = uint64(len(invalid.CovenantPks) + 1)
+	invalid.UnbondingFee = defaultParam.MinStakingAmount
+
+	next := defaultParam
+	next.Version = 2
+	next.ActivationHeight = defaultParam.ActivationHeight
+
+	data, err := json.Marshal(&parser.GlobalParams{
+		Versions: []*parser.VersionedGlobalParams{&invalid, &next},
+	})
+	require.NoError(t, err)
+
+	_, err = paramsValidator.Validate(data)
+	require.Error(t, err)
+
+	var validationErr *signerapp.GlobalParamsValidationError
+	require.True(t, errors.As(err, &validationErr))
+	require.Len(t, validationErr.Problems, 5)
+}
@@ -0,0 +1,402 @@
+package signerapp
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// Names of policy checks, reported as rejection reasons of requests violating
+// the policy
+const (
+	CheckPolicyBlockedStaker           = "policy_blocked_staker"
+	CheckPolicyBlockedFinalityProvider = "policy_blocked_finality_provider"
+	CheckPolicyMinStakingAge           = "policy_min_staking_age"
+	CheckPolicySigningWindow           = "policy_signing_window"
+	CheckPolicyUnbondingValueCap       = "policy_unbonding_value_cap"
+)
+
+// unbondingValueWindow is the period in which unbonded value is capped
+const unbondingValueWindow = time.Hour
+
+// policyFile is format of the policy file. Rules which are not set are not
+// checked.
+type policyFile struct {
+	// BIP340 x-only or compressed public keys
+	BlockedStakerPublicKeys           []string `json:"blocked_staker_public_keys"`
+	BlockedFinalityProviderPublicKeys []string `json:"blocked_finality_provider_public_keys"`
+	// MinStakingAgeBlocks is min number of confirmations of staking tx
+	MinStakingAgeBlocks uint32 `json:"min_staking_age_blocks"`
+	// MaxUnbondingValuePerHourSat caps total value of staking outputs unbonded
+	// in any 60 minutes
+	MaxUnbondingValuePerHourSat int64 `json:"max_unbonding_value_per_hour_sat"`
+	// SigningWindows are times of day when signing is allowed
+	SigningWindows []signingWindowFile `json:"signing_windows"`
+	// TimeZone of signing windows, UTC if empty
+	TimeZone string `json:"time_zone"`
+}
+
+type signingWindowFile struct {
+	// Days of week (mon|tue|wed|thu|fri|sat|sun), every day if empty
+	Days []string `json:"days"`
+	// Start and End in HH:MM format, End is exclusive and can be 24:00
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+type signingWindow struct {
+	days map[time.Weekday]bool
+	// minutes since midnight
+	start int
+	end   int
+}
+
+func (w *signingWindow) contains(t time.Time) bool {
+	if len(w.days) > 0 && !w.days[t.Weekday()] {
+		return false
+	}
+
+	minute := t.Hour()*60 + t.Minute()
+	return minute >= w.start && minute < w.end
+}
+
+// unbondedValue is value of staking output counted towards the cap
+type unbondedValue struct {
+	// staking outpoint, signing the same unbonding again does not unbond
+	// anything new
+	key    string
+	at     time.Time
+	amount int64
+	// number of signings of the output in progress
+	pending int
+	// true once the output was signed
+	signed bool
+}
+
+// Policy defines rules checked before unbonding transaction is signed, in
+// addition to the Babylon params. It is loaded from declarative policy file.
+type Policy struct {
+	blockedStakers           map[string]bool
+	blockedFinalityProviders map[string]bool
+	minStakingAgeBlocks      uint32
+	maxUnbondingValuePerHour int64
+	signingWindows           []*signingWindow
+	location                 *time.Location
+	now                      func() time.Time
+
+	// guards unbonded, so that concurrent requests cannot exceed the cap
+	mu sync.Mutex
+	// values of signed unbonding transactions, oldest first
+	unbonded []*unbondedValue
+	// unbonded values by their staking outpoint
+	unbondedByKey map[string]*unbondedValue
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// parsePolicyKeys returns set of hex encoded x-only keys
+func parsePolicyKeys(keys []string) (map[string]bool, error) {
+	res := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		keyBytes, err := hex.DecodeString(k)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %s: %w", k, err)
+		}
+
+		if len(keyBytes) == 33 {
+			keyBytes = keyBytes[1:]
+		}
+
+		pubKey, err := schnorr.ParsePubKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %s: %w", k, err)
+		}
+
+		res[hex.EncodeToString(schnorr.SerializePubKey(pubKey))] = true
+	}
+	return res, nil
+}
+
+// parseTimeOfDay returns minutes since midnight of HH:MM time
+func parseTimeOfDay(s string) (int, error) {
+	if s == "24:00" {
+		return 24 * 60, nil
+	}
+
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", s)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func parseSigningWindow(w *signingWindowFile) (*signingWindow, error) {
+	days := make(map[time.Weekday]bool, len(w.Days))
+	for _, d := range w.Days {
+		day, ok := weekdays[strings.ToLower(d)]
+		if !ok {
+			return nil, fmt.Errorf("invalid day %q, expected one of mon|tue|wed|thu|fri|sat|sun", d)
+		}
+		days[day] = true
+	}
+
+	start, err := parseTimeOfDay(w.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := parseTimeOfDay(w.End)
+	if err != nil {
+		return nil, err
+	}
+
+	if start >= end {
+		return nil, fmt.Errorf("start %s of signing window must be before its end %s", w.Start, w.End)
+	}
+
+	return &signingWindow{days: days, start: start, end: end}, nil
+}
+
+// ParsePolicy parses policy from json policy file. now returns current time,
+// it is used to check signing windows and cap of unbonded value.
+func ParsePolicy(data []byte, now func() time.Time) (*Policy, error) {
+	var f policyFile
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// misspelled rule would be silently ignored otherwise
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&f); err != nil {
+		return nil, fmt.Errorf("invalid policy: %w", err)
+	}
+
+	blockedStakers, err := parsePolicyKeys(f.BlockedStakerPublicKeys)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blocked staker: %w", err)
+	}
+
+	blockedFinalityProviders, err := parsePolicyKeys(f.BlockedFinalityProviderPublicKeys)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blocked finality provider: %w", err)
+	}
+
+	if f.MaxUnbondingValuePerHourSat < 0 {
+		return nil, fmt.Errorf("max unbonding value per hour must not be negative")
+	}
+
+	location := time.UTC
+	if f.TimeZone != "" {
+		location, err = time.LoadLocation(f.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %s: %w", f.TimeZone, err)
+		}
+	}
+
+	signingWindows := make([]*signingWindow, len(f.SigningWindows))
+	for i := range f.SigningWindows {
+		signingWindows[i], err = parseSigningWindow(&f.SigningWindows[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid signing window %d: %w", i, err)
+		}
+	}
+
+	return &Policy{
+		blockedStakers:           blockedStakers,
+		blockedFinalityProviders: blockedFinalityProviders,
+		minStakingAgeBlocks:      f.MinStakingAgeBlocks,
+		maxUnbondingValuePerHour: f.MaxUnbondingValuePerHourSat,
+		signingWindows:           signingWindows,
+		location:                 location,
+		now:                      now,
+		unbondedByKey:            make(map[string]*unbondedValue),
+	}, nil
+}
+
+// LoadPolicy reads and parses policy file
+func LoadPolicy(path string, now func() time.Time) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file %s: %w", path, err)
+	}
+
+	return ParsePolicy(data, now)
+}
+
+type policyCheckResult struct {
+	name string
+	err  error
+}
+
+// check evaluates all configured rules against validated staking transaction.
+// Cap of unbonded value is only checked here, value is counted towards the cap
+// by reserveUnbondingValue.
+func (p *Policy) check(stakingTx *stakingTxData) []policyCheckResult {
+	var results []policyCheckResult
+	result := func(name string, err error) {
+		if err != nil {
+			err = wrapInvalidSigningRequestError(err)
+		}
+		results = append(results, policyCheckResult{name: name, err: err})
+	}
+
+	if len(p.blockedStakers) > 0 {
+		var err error
+		stakerKey := hex.EncodeToString(schnorr.SerializePubKey(stakingTx.stakerPublicKey))
+		if p.blockedStakers[stakerKey] {
+			err = fmt.Errorf("staker %s is blocked by policy", stakerKey)
+		}
+		result(CheckPolicyBlockedStaker, err)
+	}
+
+	if len(p.blockedFinalityProviders) > 0 {
+		var err error
+		for _, fpPubKey := range stakingTx.finalityProviderPublicKeys {
+			fpKey := hex.EncodeToString(schnorr.SerializePubKey(fpPubKey))
+			if p.blockedFinalityProviders[fpKey] {
+				err = fmt.Errorf("finality provider %s is blocked by policy", fpKey)
+				break
+			}
+		}
+		result(CheckPolicyBlockedFinalityProvider, err)
+	}
+
+	if p.minStakingAgeBlocks > 0 {
+		var err error
+		if stakingTx.confirmations < int64(p.minStakingAgeBlocks) {
+			err = fmt.Errorf(
+				"staking tx has %d confirmations, policy requires at least %d",
+				stakingTx.confirmations,
+				p.minStakingAgeBlocks,
+			)
+		}
+		result(CheckPolicyMinStakingAge, err)
+	}
+
+	if len(p.signingWindows) > 0 {
+		now := p.now().In(p.location)
+		err := fmt.Errorf("signing is not allowed by policy at %s", now.Format("Mon 15:04 MST"))
+		for _, w := range p.signingWindows {
+			if w.contains(now) {
+				err = nil
+				break
+			}
+		}
+		result(CheckPolicySigningWindow, err)
+	}
+
+	if p.maxUnbondingValuePerHour > 0 {
+		p.mu.Lock()
+		err := p.checkUnbondingValue(unbondedValueKey(stakingTx), stakingTx.stakingOutput.Value)
+		p.mu.Unlock()
+		result(CheckPolicyUnbondingValueCap, err)
+	}
+
+	return results
+}
+
+// unbondedValueKey identifies staking output unbonded by the transaction
+func unbondedValueKey(stakingTx *stakingTxData) string {
+	stakingTxHash := stakingTx.txInfo.Tx.TxHash()
+	return wire.NewOutPoint(&stakingTxHash, stakingTx.stakingOutputIdx).String()
+}
+
+// checkUnbondingValue returns error if unbonding the amount of staking output
+// identified by key would exceed the cap. Output which is already counted
+// does not count again. Must be called with mu held.
+func (p *Policy) checkUnbondingValue(key string, amount int64) error {
+	windowStart := p.now().Add(-unbondingValueWindow)
+
+	// drop values which left the window, including values still being signed,
+	// as they are counted from the time they were reserved
+	i := 0
+	for i < len(p.unbonded) && !p.unbonded[i].at.After(windowStart) {
+		delete(p.unbondedByKey, p.unbonded[i].key)
+		i++
+	}
+	p.unbonded = p.unbonded[i:]
+
+	if _, ok := p.unbondedByKey[key]; ok {
+		return nil
+	}
+
+	var total int64
+	for _, v := range p.unbonded {
+		total += v.amount
+	}
+
+	if total+amount > p.maxUnbondingValuePerHour {
+		return fmt.Errorf(
+			"unbonding %d sat would exceed policy cap of %d sat per hour, %d sat already unbonded",
+			amount,
+			p.maxUnbondingValuePerHour,
+			total,
+		)
+	}
+
+	return nil
+}
+
+// reserveUnbondingValue counts the amount of staking output towards the cap of
+// unbonded value, unless the output is already counted, e.g. when the same
+// unbonding transaction is signed again. Returned function must be called once
+// signing finished, the amount is released if the output was not signed.
+func (p *Policy) reserveUnbondingValue(stakingTx *stakingTxData) (func(signed bool), error) {
+	if p.maxUnbondingValuePerHour == 0 {
+		return func(bool) {}, nil
+	}
+
+	key := unbondedValueKey(stakingTx)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.checkUnbondingValue(key, stakingTx.stakingOutput.Value); err != nil {
+		return nil, newCheckError(CheckPolicyUnbondingValueCap, wrapInvalidSigningRequestError(err))
+	}
+
+	reserved, ok := p.unbondedByKey[key]
+	if !ok {
+		reserved = &unbondedValue{key: key, at: p.now(), amount: stakingTx.stakingOutput.Value}
+		p.unbonded = append(p.unbonded, reserved)
+		p.unbondedByKey[key] = reserved
+	}
+	reserved.pending++
+
+	return func(signed bool) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		reserved.pending--
+		reserved.signed = reserved.signed || signed
+		if reserved.signed || reserved.pending > 0 {
+			return
+		}
+
+		// the value could have already left the window and the output could
+		// have been reserved again
+		if p.unbondedByKey[key] == reserved {
+			delete(p.unbondedByKey, key)
+		}
+		for i, v := range p.unbonded {
+			if v == reserved {
+				p.unbonded = append(p.unbonded[:i], p.unbonded[i+1:]...)
+				return
+			}
+		}
+	}, nil
+}
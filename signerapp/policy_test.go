@@ -0,0 +1,254 @@
+package signerapp_test
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// expectValidStakingTx makes chain info and params retriever return valid
+// staking tx included at height 200 with best block 300
+func expectValidStakingTx(deps *MockedDependencies, validData *TestData) {
+	deps.bi.EXPECT().TxByHash(
+		gomock.Any(),
+		&validData.UnbondingTx.TxIn[0].PreviousOutPoint.Hash,
+		validData.StakingInfo.StakingOutput.PkScript).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	).AnyTimes()
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil).AnyTimes()
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(deps.params, nil).AnyTimes()
+	deps.bi.EXPECT().BlockHashByHeight(gomock.Any(), uint32(200)).Return(&stakingTxBlockHash, nil).AnyTimes()
+}
+
+func signUnbonding(signerApp *signerapp.SignerApp, deps *MockedDependencies, validData *TestData) error {
+	_, err := signerApp.SignUnbondingTransaction(
+		context.Background(),
+		validData.StakingInfo.StakingOutput.PkScript,
+		validData.UnbondingTx,
+		validData.UnbondingTxStakerSig,
+		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+	return err
+}
+
+func requireRejectedBy(t *testing.T, err error, check string) {
+	require.ErrorIs(t, err, signerapp.ErrInvalidSigningRequest)
+	reason, ok := signerapp.RejectionReason(err)
+	require.True(t, ok)
+	require.Equal(t, check, reason)
+}
+
+func TestPolicyRejectsBlockedKeys(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	validData := NewValidTestData(t, deps.params)
+	expectValidStakingTx(deps, validData)
+
+	stakerKey := hex.EncodeToString(validData.StakerPubKey.SerializeCompressed())
+	fpKey := hex.EncodeToString(schnorr.SerializePubKey(validData.FinalityProviderPublicKey))
+
+	policy, err := signerapp.ParsePolicy([]byte(fmt.Sprintf(`{"blocked_staker_public_keys": [%q]}`, stakerKey)), time.Now)
+	require.NoError(t, err)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	signerApp.SetPolicy(policy)
+	requireRejectedBy(t, signUnbonding(signerApp, deps, validData), signerapp.CheckPolicyBlockedStaker)
+
+	policy, err = signerapp.ParsePolicy([]byte(fmt.Sprintf(`{"blocked_finality_provider_public_keys": [%q]}`, fpKey)), time.Now)
+	require.NoError(t, err)
+	signerApp.SetPolicy(policy)
+	requireRejectedBy(t, signUnbonding(signerApp, deps, validData), signerapp.CheckPolicyBlockedFinalityProvider)
+}
+
+// expectRawSignatures makes the signer return staker signature, as it does not
+// matter for test correctness
+func expectRawSignatures(deps *MockedDependencies, validData *TestData, times int) {
+	deps.s.EXPECT().RawSignature(gomock.Any(), gomock.Any()).Return(&signerapp.SigningResult{
+		Signature: validData.UnbondingTxStakerSig,
+	}, nil).Times(times)
+}
+
+func TestPolicyCapsUnbondedValuePerHour(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	first := NewValidTestData(t, deps.params)
+	second := NewValidTestData(t, deps.params)
+	expectValidStakingTx(deps, first)
+	expectValidStakingTx(deps, second)
+
+	stakingAmount := first.StakingInfo.StakingOutput.Value
+	require.Equal(t, stakingAmount, second.StakingInfo.StakingOutput.Value)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	policy, err := signerapp.ParsePolicy(
+		[]byte(fmt.Sprintf(`{"max_unbonding_value_per_hour_sat": %d}`, stakingAmount+stakingAmount/2)),
+		func() time.Time { return now },
+	)
+	require.NoError(t, err)
+
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	signerApp.SetPolicy(policy)
+
+	// failed signing does not count towards the cap
+	deps.s.EXPECT().RawSignature(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("wallet locked"))
+	require.ErrorContains(t, signUnbonding(signerApp, deps, first), "wallet locked")
+
+	expectRawSignatures(deps, first, 2)
+	require.NoError(t, signUnbonding(signerApp, deps, first))
+
+	now = now.Add(30 * time.Minute)
+	requireRejectedBy(t, signUnbonding(signerApp, deps, second), signerapp.CheckPolicyUnbondingValueCap)
+
+	// the first unbonding leaves the window
+	now = now.Add(31 * time.Minute)
+	require.NoError(t, signUnbonding(signerApp, deps, second))
+}
+
+func TestPolicyDropsExpiredValueBehindPendingSigning(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	first := NewValidTestData(t, deps.params)
+	second := NewValidTestData(t, deps.params)
+	third := NewValidTestData(t, deps.params)
+	expectValidStakingTx(deps, first)
+	expectValidStakingTx(deps, second)
+	expectValidStakingTx(deps, third)
+
+	stakingAmount := first.StakingInfo.StakingOutput.Value
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	policy, err := signerapp.ParsePolicy(
+		[]byte(fmt.Sprintf(`{"max_unbonding_value_per_hour_sat": %d}`, 2*stakingAmount)),
+		func() time.Time { return now },
+	)
+	require.NoError(t, err)
+
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	signerApp.SetPolicy(policy)
+
+	// signing of the first unbonding hangs until it is released
+	started := make(chan struct{})
+	release := make(chan struct{})
+	deps.s.EXPECT().RawSignature(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, *signerapp.SigningRequest) (*signerapp.SigningResult, error) {
+			close(started)
+			<-release
+			return &signerapp.SigningResult{Signature: first.UnbondingTxStakerSig}, nil
+		},
+	)
+	firstErr := make(chan error)
+	go func() {
+		firstErr <- signUnbonding(signerApp, deps, first)
+	}()
+	<-started
+
+	// the second unbonding is signed while the first one is still pending
+	now = now.Add(time.Minute)
+	expectRawSignatures(deps, second, 1)
+	require.NoError(t, signUnbonding(signerApp, deps, second))
+
+	// both unbondings left the window, although the first one is still pending
+	now = now.Add(2 * time.Hour)
+	expectRawSignatures(deps, third, 1)
+	require.NoError(t, signUnbonding(signerApp, deps, third))
+
+	// releasing the expired reservation does not affect values in the window
+	close(release)
+	require.NoError(t, <-firstErr)
+	expectRawSignatures(deps, first, 1)
+	require.NoError(t, signUnbonding(signerApp, deps, first))
+	requireRejectedBy(t, signUnbonding(signerApp, deps, second), signerapp.CheckPolicyUnbondingValueCap)
+}
+
+func TestPolicyDoesNotCountSigningSameUnbondingTwice(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	first := NewValidTestData(t, deps.params)
+	second := NewValidTestData(t, deps.params)
+	expectValidStakingTx(deps, first)
+	expectValidStakingTx(deps, second)
+
+	policy, err := signerapp.ParsePolicy(
+		[]byte(fmt.Sprintf(`{"max_unbonding_value_per_hour_sat": %d}`, first.StakingInfo.StakingOutput.Value)),
+		time.Now,
+	)
+	require.NoError(t, err)
+
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	signerApp.SetPolicy(policy)
+
+	// retries of the same request, e.g. by several clients of the quorum
+	expectRawSignatures(deps, first, 3)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, signUnbonding(signerApp, deps, first))
+	}
+
+	// the cap is used by the first unbonding only once
+	requireRejectedBy(t, signUnbonding(signerApp, deps, second), signerapp.CheckPolicyUnbondingValueCap)
+}
+
+func TestPolicyChecksAreReportedByValidation(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	validData := NewValidTestData(t, deps.params)
+	expectValidStakingTx(deps, validData)
+
+	// Monday
+	now := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	policy, err := signerapp.ParsePolicy([]byte(`{
+		"min_staking_age_blocks": 144,
+		"signing_windows": [{"days": ["mon", "tue"], "start": "08:00", "end": "24:00"}],
+		"time_zone": "UTC"
+	}`), func() time.Time { return now })
+	require.NoError(t, err)
+
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	signerApp.SetPolicy(policy)
+
+	report, err := signerApp.ValidateUnbondingTransaction(
+		context.Background(),
+		validData.StakingInfo.StakingOutput.PkScript,
+		validData.UnbondingTx,
+		validData.UnbondingTxStakerSig,
+		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+	require.NoError(t, err)
+	require.False(t, report.Valid())
+
+	failed := map[string]bool{}
+	for _, c := range report.Checks {
+		if !c.Passed {
+			failed[c.Name] = true
+		}
+	}
+	// staking tx has 101 confirmations and 7:00 is outside of signing window
+	require.Equal(t, map[string]bool{
+		signerapp.CheckPolicyMinStakingAge: true,
+		signerapp.CheckPolicySigningWindow: true,
+	}, failed)
+
+	now = now.Add(2 * time.Hour)
+	requireRejectedBy(t, signUnbonding(signerApp, deps, validData), signerapp.CheckPolicyMinStakingAge)
+}
+
+func TestParsePolicyRejectsInvalidRules(t *testing.T) {
+	for name, policy := range map[string]string{
+		"unknown rule": `{"max_unbonding_value_per_day_sat": 1}`,
+		"invalid key":  `{"blocked_staker_public_keys": ["00"]}`,
+		"negative cap": `{"max_unbonding_value_per_hour_sat": -1}`,
+		"invalid day":  `{"signing_windows": [{"days": ["monday"], "start": "08:00", "end": "16:00"}]}`,
+		"empty window": `{"signing_windows": [{"start": "16:00", "end": "08:00"}]}`,
+		"invalid time": `{"signing_windows": [{"start": "8am", "end": "16:00"}]}`,
+		"invalid zone": `{"time_zone": "Mars/Olympus_Mons"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := signerapp.ParsePolicy([]byte(policy), time.Now)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package signerapp
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/fsnotify/fsnotify"
+)
+
+var _ ReloadableParams = &ReloadableParamsRetriever{}
+
+// ReloadableParamsRetriever serves params from global params file and swaps
+// them in place whenever the file changes on disk. New params are only accepted
+// if they are valid and do not modify any of the already loaded versions.
+type ReloadableParamsRetriever struct {
+	*paramsStore
+	path string
+}
+
+func NewReloadableParamsRetriever(
+	path string,
+	validator *GlobalParamsValidator,
+	metrics *m.CovenantSignerMetrics,
+) (*ReloadableParamsRetriever, error) {
+	r := &ReloadableParamsRetriever{
+		paramsStore: newParamsStore(validator, metrics),
+		path:        filepath.Clean(path),
+	}
+
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Reload reads params file from disk and swaps active params if the file
+// contains valid params. On error, previously loaded params stay active.
+func (r *ReloadableParamsRetriever) Reload() error {
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return r.updateFailed(fmt.Errorf("failed to read global params file %s: %w", r.path, err))
+	}
+
+	_, err = r.update(r.path, data)
+	return err
+}
+
+// ReloadParams reloads params file on demand
+func (r *ReloadableParamsRetriever) ReloadParams(_ context.Context) error {
+	return r.Reload()
+}
+
+// Start reloads params every time the params file changes, until the context
+// is cancelled. The parent directory is watched instead of the file itself, as
+// files are often replaced by renaming instead of being written in place.
+func (r *ReloadableParamsRetriever) Start(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create global params file watcher: %w", err)
+	}
+
+	if err := watcher.Add(filepath.Dir(r.path)); err != nil {
+		_ = watcher.Close()
+		return fmt.Errorf("failed to watch global params file %s: %w", r.path, err)
+	}
+
+	go func() {
+		defer watcher.Close()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+
+				if filepath.Clean(event.Name) != r.path ||
+					!event.Has(fsnotify.Write|fsnotify.Create|fsnotify.Rename) {
+					continue
+				}
+
+				if err := r.Reload(); err != nil {
+					logging.Logger(logging.ComponentParams).Error().Err(err).Msg("rejected global params update, keeping previous params")
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logging.Logger(logging.ComponentParams).Error().Err(err).Msg("global params file watcher error")
+			}
+		}
+	}()
+
+	return nil
+}
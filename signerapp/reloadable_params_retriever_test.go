@@ -0,0 +1,83 @@
+package signerapp_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/babylonlabs-io/networks/parameters/parser"
+	"github.com/stretchr/testify/require"
+)
+
+func writeParamsFile(t *testing.T, path string, params *parser.GlobalParams) {
+	data, err := json.Marshal(params)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+func paramsWithNextVersion(activationHeight uint64) *parser.GlobalParams {
+	nextVersion := defaultParam
+	nextVersion.Version = defaultParam.Version + 1
+	nextVersion.ActivationHeight = activationHeight
+	nextVersion.ConfirmationDepth = defaultParam.ConfirmationDepth + 1
+
+	return &parser.GlobalParams{
+		Versions: []*parser.VersionedGlobalParams{&defaultParam, &nextVersion},
+	}
+}
+
+func TestReloadParams(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "global-params.json")
+	writeParamsFile(t, path, &globalParams)
+
+	r, err := signerapp.NewReloadableParamsRetriever(path, paramsValidator, metrics.NewCovenantSignerMetrics())
+	require.NoError(t, err)
+	require.Len(t, r.GlobalParams().Versions, 1)
+
+	// invalid file is rejected and old params are kept
+	require.NoError(t, os.WriteFile(path, []byte("{\"versions\": []}"), 0o600))
+	require.Error(t, r.Reload())
+	require.Len(t, r.GlobalParams().Versions, 1)
+
+	// modification of already loaded version is rejected
+	modified := defaultParam
+	modified.ConfirmationDepth = defaultParam.ConfirmationDepth + 1
+	writeParamsFile(t, path, &parser.GlobalParams{
+		Versions: []*parser.VersionedGlobalParams{&modified},
+	})
+	require.Error(t, r.Reload())
+	p, err := r.ParamsByHeight(context.Background(), defaultParam.ActivationHeight)
+	require.NoError(t, err)
+	require.Equal(t, uint16(defaultParam.ConfirmationDepth), p.ConfirmationDepth)
+
+	// new version is accepted
+	writeParamsFile(t, path, paramsWithNextVersion(200))
+	require.NoError(t, r.Reload())
+	require.Len(t, r.GlobalParams().Versions, 2)
+	p, err = r.ParamsByHeight(context.Background(), 200)
+	require.NoError(t, err)
+	require.Equal(t, uint16(defaultParam.ConfirmationDepth+1), p.ConfirmationDepth)
+}
+
+func TestWatchParamsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "global-params.json")
+	writeParamsFile(t, path, &globalParams)
+
+	r, err := signerapp.NewReloadableParamsRetriever(path, paramsValidator, metrics.NewCovenantSignerMetrics())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, r.Start(ctx))
+
+	writeParamsFile(t, path, paramsWithNextVersion(200))
+
+	require.Eventually(t, func() bool {
+		return len(r.GlobalParams().Versions) == 2
+	}, 5*time.Second, 50*time.Millisecond)
+}
@@ -0,0 +1,246 @@
+package signerapp
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/babylonlabs-io/covenant-signer/config"
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+)
+
+const (
+	// global params files are small, 1MB is more than enough
+	maxRemoteParamsSize = 1 << 20
+	// max time of single remote params request
+	remoteParamsRequestTimeout = 30 * time.Second
+)
+
+var _ ReloadableParams = &RemoteParamsRetriever{}
+
+// RemoteParamsRetriever polls global params from a remote https url. New params
+// are only accepted if they match the pinned hash and/or are signed by the
+// configured key. Accepted params are cached on disk, so that the signer can
+// start even if the remote is temporarily unreachable.
+type RemoteParamsRetriever struct {
+	*paramsStore
+	cfg       *config.ParsedParamsConfig
+	client    *http.Client
+	cachePath string
+}
+
+func NewRemoteParamsRetriever(
+	ctx context.Context,
+	cfg *config.ParsedParamsConfig,
+	client *http.Client,
+	cachePath string,
+	validator *GlobalParamsValidator,
+	metrics *m.CovenantSignerMetrics,
+) (*RemoteParamsRetriever, error) {
+	if cfg.Source != config.ParamsSourceRemote {
+		return nil, fmt.Errorf("invalid params source %s for remote params retriever", cfg.Source)
+	}
+
+	if client == nil {
+		client = &http.Client{Timeout: remoteParamsRequestTimeout}
+	}
+
+	r := &RemoteParamsRetriever{
+		paramsStore: newParamsStore(validator, metrics),
+		cfg:         cfg,
+		client:      client,
+		cachePath:   filepath.Clean(cachePath),
+	}
+
+	if err := r.Poll(ctx); err != nil {
+		logging.Logger(logging.ComponentParams).Warn().Err(err).Msg("failed to retrieve remote global params, falling back to cached params")
+
+		if err := r.loadCache(); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+func (r *RemoteParamsRetriever) fetch(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request to %s failed with status code: %d", url, res.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxRemoteParamsSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) > maxRemoteParamsSize {
+		return nil, fmt.Errorf("response from %s is larger than %d bytes", url, maxRemoteParamsSize)
+	}
+
+	return body, nil
+}
+
+// verify checks that params data matches pinned hash and that the signature
+// over params hash was created by configured key. If both are configured, both
+// checks must pass
+func (r *RemoteParamsRetriever) verify(data []byte, signature []byte) error {
+	hash := sha256.Sum256(data)
+
+	if r.cfg.PinnedSha256 != nil && !bytes.Equal(hash[:], r.cfg.PinnedSha256) {
+		return fmt.Errorf(
+			"global params hash %s does not match pinned hash %s",
+			hex.EncodeToString(hash[:]),
+			hex.EncodeToString(r.cfg.PinnedSha256),
+		)
+	}
+
+	if r.cfg.SignaturePublicKey != nil {
+		sig, err := schnorr.ParseSignature(signature)
+		if err != nil {
+			return fmt.Errorf("invalid global params signature: %w", err)
+		}
+
+		if !sig.Verify(hash[:], r.cfg.SignaturePublicKey) {
+			return fmt.Errorf("global params signature verification failed")
+		}
+	}
+
+	return nil
+}
+
+// Poll retrieves params from the remote and swaps active params if the remote
+// params are valid and verified. On error, previously loaded params stay active.
+func (r *RemoteParamsRetriever) Poll(ctx context.Context) error {
+	data, err := r.fetch(ctx, r.cfg.RemoteUrl)
+	if err != nil {
+		return r.updateFailed(fmt.Errorf("failed to retrieve remote global params: %w", err))
+	}
+
+	var signature []byte
+	if r.cfg.SignaturePublicKey != nil {
+		sigHex, err := r.fetch(ctx, r.cfg.SignatureUrl)
+		if err != nil {
+			return r.updateFailed(fmt.Errorf("failed to retrieve remote global params signature: %w", err))
+		}
+
+		signature, err = hex.DecodeString(strings.TrimSpace(string(sigHex)))
+		if err != nil {
+			return r.updateFailed(fmt.Errorf("invalid global params signature encoding: %w", err))
+		}
+	}
+
+	if err := r.verify(data, signature); err != nil {
+		return r.updateFailed(err)
+	}
+
+	updated, err := r.update(r.cfg.RemoteUrl, data)
+	if err != nil {
+		return err
+	}
+
+	if updated {
+		if err := r.writeCache(data, signature); err != nil {
+			// params are valid and already active, failing to cache them only
+			// matters on next restart
+			logging.Logger(logging.ComponentParams).Error().Err(err).Str("path", r.cachePath).Msg("failed to cache global params")
+		}
+	}
+
+	return nil
+}
+
+// signatureCachePath is path of the cached detached signature of cached params
+func (r *RemoteParamsRetriever) signatureCachePath() string {
+	return r.cachePath + ".sig"
+}
+
+// writeCache stores verified params together with their signature, so that
+// the cache can be verified again when it is loaded
+func (r *RemoteParamsRetriever) writeCache(data []byte, signature []byte) error {
+	if r.cfg.SignaturePublicKey != nil {
+		// signature is written first, params written with stale signature fail
+		// the verification instead of being accepted
+		sigHex := []byte(hex.EncodeToString(signature))
+		if err := os.WriteFile(r.signatureCachePath(), sigHex, 0o600); err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(r.cachePath, data, 0o600)
+}
+
+// ReloadParams polls remote params on demand
+func (r *RemoteParamsRetriever) ReloadParams(ctx context.Context) error {
+	return r.Poll(ctx)
+}
+
+func (r *RemoteParamsRetriever) loadCache() error {
+	data, err := os.ReadFile(r.cachePath)
+	if err != nil {
+		return r.updateFailed(fmt.Errorf("failed to read cached global params %s: %w", r.cachePath, err))
+	}
+
+	// cache file could have been modified since it was written, it is verified
+	// the same way as the remote params
+	var signature []byte
+	if r.cfg.SignaturePublicKey != nil {
+		sigHex, err := os.ReadFile(r.signatureCachePath())
+		if err != nil {
+			return r.updateFailed(fmt.Errorf("cached global params %s cannot be verified, failed to read signature: %w", r.cachePath, err))
+		}
+
+		signature, err = hex.DecodeString(strings.TrimSpace(string(sigHex)))
+		if err != nil {
+			return r.updateFailed(fmt.Errorf("invalid cached global params signature encoding: %w", err))
+		}
+	}
+
+	if err := r.verify(data, signature); err != nil {
+		return r.updateFailed(fmt.Errorf("cached global params %s: %w", r.cachePath, err))
+	}
+
+	_, err = r.update(r.cachePath, data)
+	return err
+}
+
+// Start polls remote params in configured interval until the context is cancelled
+func (r *RemoteParamsRetriever) Start(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(r.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.Poll(ctx); err != nil {
+					logging.Logger(logging.ComponentParams).Error().Err(err).Msg("rejected remote global params update, keeping previous params")
+				}
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package signerapp_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/babylonlabs-io/covenant-signer/config"
+	"github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/babylonlabs-io/networks/parameters/parser"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/stretchr/testify/require"
+)
+
+// paramsServer serves global params file and its signature
+type paramsServer struct {
+	*httptest.Server
+	mu        sync.Mutex
+	params    []byte
+	signature []byte
+}
+
+func newParamsServer(t *testing.T) *paramsServer {
+	s := &paramsServer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/global-params.json", func(w http.ResponseWriter, _ *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		_, _ = w.Write(s.params)
+	})
+	mux.HandleFunc("/global-params.json.sig", func(w http.ResponseWriter, _ *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		_, _ = w.Write([]byte(hex.EncodeToString(s.signature)))
+	})
+	s.Server = httptest.NewTLSServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *paramsServer) set(t *testing.T, params *parser.GlobalParams, key *btcec.PrivateKey) []byte {
+	data, err := json.Marshal(params)
+	require.NoError(t, err)
+	hash := sha256.Sum256(data)
+	sig, err := schnorr.Sign(key, hash[:])
+	require.NoError(t, err)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.params = data
+	s.signature = sig.Serialize()
+	return hash[:]
+}
+
+func remoteParamsConfig(t *testing.T, s *paramsServer, cfg *config.ParamsConfig) *config.ParsedParamsConfig {
+	cfg.Source = string(config.ParamsSourceRemote)
+	cfg.RemoteUrl = s.URL + "/global-params.json"
+	cfg.PollInterval = 1
+	cfg.CacheFile = "global-params.cache.json"
+	parsed, err := cfg.Parse()
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestRemoteParamsSignatureVerification(t *testing.T) {
+	s := newParamsServer(t)
+	signingKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	s.set(t, &globalParams, signingKey)
+
+	cfg := remoteParamsConfig(t, s, &config.ParamsConfig{
+		SignaturePublicKey: hex.EncodeToString(schnorr.SerializePubKey(signingKey.PubKey())),
+	})
+	cachePath := filepath.Join(t.TempDir(), "global-params.json")
+
+	r, err := signerapp.NewRemoteParamsRetriever(
+		context.Background(), cfg, s.Client(), cachePath, paramsValidator, metrics.NewCovenantSignerMetrics(),
+	)
+	require.NoError(t, err)
+	require.Len(t, r.GlobalParams().Versions, 1)
+
+	// verified params are cached
+	cached, err := os.ReadFile(cachePath)
+	require.NoError(t, err)
+	require.Equal(t, s.params, cached)
+
+	// params signed by unknown key are rejected
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	s.set(t, paramsWithNextVersion(200), otherKey)
+	require.Error(t, r.Poll(context.Background()))
+	require.Len(t, r.GlobalParams().Versions, 1)
+
+	// params signed by configured key are accepted
+	s.set(t, paramsWithNextVersion(200), signingKey)
+	require.NoError(t, r.Poll(context.Background()))
+	require.Len(t, r.GlobalParams().Versions, 2)
+}
+
+func TestRemoteParamsPinnedHash(t *testing.T) {
+	s := newParamsServer(t)
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	pinnedHash := s.set(t, &globalParams, key)
+
+	cfg := remoteParamsConfig(t, s, &config.ParamsConfig{
+		PinnedSha256: hex.EncodeToString(pinnedHash),
+	})
+	cachePath := filepath.Join(t.TempDir(), "global-params.json")
+
+	r, err := signerapp.NewRemoteParamsRetriever(
+		context.Background(), cfg, s.Client(), cachePath, paramsValidator, metrics.NewCovenantSignerMetrics(),
+	)
+	require.NoError(t, err)
+
+	// params not matching pinned hash are rejected
+	s.set(t, paramsWithNextVersion(200), key)
+	require.Error(t, r.Poll(context.Background()))
+	require.Len(t, r.GlobalParams().Versions, 1)
+
+	// cached params are used when remote is unreachable
+	s.Close()
+	r, err = signerapp.NewRemoteParamsRetriever(
+		context.Background(), cfg, s.Client(), cachePath, paramsValidator, metrics.NewCovenantSignerMetrics(),
+	)
+	require.NoError(t, err)
+	require.Len(t, r.GlobalParams().Versions, 1)
+}
+
+func TestRemoteParamsRequiresPinnedHashAndSignature(t *testing.T) {
+	s := newParamsServer(t)
+	signingKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	pinnedHash := s.set(t, &globalParams, signingKey)
+
+	cfg := remoteParamsConfig(t, s, &config.ParamsConfig{
+		PinnedSha256:       hex.EncodeToString(pinnedHash),
+		SignaturePublicKey: hex.EncodeToString(schnorr.SerializePubKey(signingKey.PubKey())),
+	})
+	cachePath := filepath.Join(t.TempDir(), "global-params.cache.json")
+
+	r, err := signerapp.NewRemoteParamsRetriever(
+		context.Background(), cfg, s.Client(), cachePath, paramsValidator, metrics.NewCovenantSignerMetrics(),
+	)
+	require.NoError(t, err)
+
+	// params signed by configured key but not matching pinned hash are rejected
+	s.set(t, paramsWithNextVersion(200), signingKey)
+	require.Error(t, r.Poll(context.Background()))
+	require.Len(t, r.GlobalParams().Versions, 1)
+
+	// params matching pinned hash but signed by unknown key are rejected
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	s.set(t, &globalParams, otherKey)
+	require.Error(t, r.Poll(context.Background()))
+}
+
+func TestRemoteParamsVerifiesCachedSignature(t *testing.T) {
+	s := newParamsServer(t)
+	signingKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	s.set(t, &globalParams, signingKey)
+
+	cfg := remoteParamsConfig(t, s, &config.ParamsConfig{
+		SignaturePublicKey: hex.EncodeToString(schnorr.SerializePubKey(signingKey.PubKey())),
+	})
+	cachePath := filepath.Join(t.TempDir(), "global-params.json")
+
+	_, err = signerapp.NewRemoteParamsRetriever(
+		context.Background(), cfg, s.Client(), cachePath, paramsValidator, metrics.NewCovenantSignerMetrics(),
+	)
+	require.NoError(t, err)
+	s.Close()
+
+	newRetriever := func() (*signerapp.RemoteParamsRetriever, error) {
+		return signerapp.NewRemoteParamsRetriever(
+			context.Background(), cfg, s.Client(), cachePath, paramsValidator, metrics.NewCovenantSignerMetrics(),
+		)
+	}
+
+	// cached params with valid signature are used when remote is unreachable
+	r, err := newRetriever()
+	require.NoError(t, err)
+	require.Len(t, r.GlobalParams().Versions, 1)
+
+	// modified cached params are rejected
+	cached, err := os.ReadFile(cachePath)
+	require.NoError(t, err)
+	modified, err := json.Marshal(paramsWithNextVersion(200))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cachePath, modified, 0o600))
+	_, err = newRetriever()
+	require.Error(t, err)
+
+	// cached params without signature are rejected
+	require.NoError(t, os.WriteFile(cachePath, cached, 0o600))
+	require.NoError(t, os.Remove(cachePath+".sig"))
+	_, err = newRetriever()
+	require.Error(t, err)
+}
@@ -4,10 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/babylonlabs-io/babylon/btcstaking"
+	asig "github.com/babylonlabs-io/babylon/crypto/schnorr-adaptor-signature"
 	"github.com/babylonlabs-io/covenant-signer/config"
+	"github.com/babylonlabs-io/covenant-signer/observability/tracing"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
@@ -18,18 +22,57 @@ import (
 
 var (
 	ErrInvalidSigningRequest = fmt.Errorf("invalid signing request")
+	// ErrStakingTxReorged is returned when the block including the staking
+	// transaction is no longer part of the best chain. Request can be retried
+	// once the node settles on the new chain.
+	ErrStakingTxReorged = fmt.Errorf("staking transaction block re-orged out of best chain")
+	// ErrTxNotInChain is returned by BtcChainInfo when requested transaction
+	// is not included in the best chain. Other errors are failures of the chain
+	// backend.
+	ErrTxNotInChain = fmt.Errorf("transaction is not in chain")
+	// errValidationStopped is returned during dry-run validation when
+	// following checks are impossible after failed check
+	errValidationStopped = fmt.Errorf("validation stopped on failed check")
 )
 
 func wrapInvalidSigningRequestError(err error) error {
 	return fmt.Errorf("%s: %w", err, ErrInvalidSigningRequest)
 }
 
+func wrapStakingTxReorgedError(err error) error {
+	return fmt.Errorf("%s: %w", err, ErrStakingTxReorged)
+}
+
 type SignerApp struct {
 	s   ExternalBtcSigner
 	r   BtcChainInfo
 	p   BabylonParamsRetriever
 	cfg *config.ParsedSignerAppConfig
 	net *chaincfg.Params
+	// nil if signing cannot be frozen
+	freeze *Freeze
+	// nil if no policy is configured
+	policy *Policy
+	// committees of params versions used so far
+	committees *covenantCommittees
+}
+
+// covenantCommittees is set of BIP340 x-only keys of covenant committees of
+// params versions used by the app. Keys come only from params, so the set
+// stays small regardless of keys received in requests.
+type covenantCommittees struct {
+	keys sync.Map
+}
+
+func (c *covenantCommittees) add(keys []*btcec.PublicKey) {
+	for _, key := range keys {
+		c.keys.LoadOrStore(hex.EncodeToString(schnorr.SerializePubKey(key)), struct{}{})
+	}
+}
+
+func (c *covenantCommittees) contains(key *btcec.PublicKey) bool {
+	_, ok := c.keys.Load(hex.EncodeToString(schnorr.SerializePubKey(key)))
+	return ok
 }
 
 func NewSignerApp(
@@ -40,12 +83,59 @@ func NewSignerApp(
 	net *chaincfg.Params,
 ) *SignerApp {
 	return &SignerApp{
-		s:   s,
-		r:   r,
-		p:   p,
-		cfg: cfg,
-		net: net,
+		s:          s,
+		r:          r,
+		p:          p,
+		cfg:        cfg,
+		net:        net,
+		committees: &covenantCommittees{},
+	}
+}
+
+// IsKnownCovenantMember returns true if the key is member of covenant
+// committee of any params version used to validate requests so far. It does
+// not retrieve params, so it can be used on error paths, e.g. to decide if the
+// key received in request can be used as metric label.
+func (s *SignerApp) IsKnownCovenantMember(pubKey *btcec.PublicKey) bool {
+	return pubKey != nil && s.committees.contains(pubKey)
+}
+
+// SetFreeze makes signing stop while the freeze is active. Must be called
+// before the app starts handling requests.
+func (s *SignerApp) SetFreeze(freeze *Freeze) {
+	s.freeze = freeze
+}
+
+// SetPolicy makes unbonding transactions signed only if they comply with the
+// policy. Must be called before the app starts handling requests.
+func (s *SignerApp) SetPolicy(policy *Policy) {
+	s.policy = policy
+}
+
+// checkPolicy checks validated staking transaction of unbonding request
+// against the policy
+func (s *SignerApp) checkPolicy(report *UnbondingValidationReport, stakingTx *stakingTxData) error {
+	if s.policy == nil {
+		return nil
+	}
+
+	for _, r := range s.policy.check(stakingTx) {
+		if err := report.check(r.name, r.err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkNotFrozen must be called right before the covenant key is used, so
+// that freeze also stops requests which were already being validated
+func (s *SignerApp) checkNotFrozen() error {
+	if s.freeze.Frozen() {
+		return ErrSigningFrozen
 	}
+
+	return nil
 }
 
 func (s *SignerApp) pubKeyToAddress(pubKey *btcec.PublicKey) (btcutil.Address, error) {
@@ -60,9 +150,13 @@ func (s *SignerApp) pubKeyToAddress(pubKey *btcec.PublicKey) (btcutil.Address, e
 	return witnessAddr, nil
 }
 
+// isCovenantMember compares keys in BIP340 x-only format, as this is the format
+// used in staking scripts. Params retrieved from Babylon node contain only x-only
+// keys, while the request contains full compressed key.
 func isCovenantMember(pubKey *btcec.PublicKey, covenantKeys []*btcec.PublicKey) bool {
+	xOnlyPubKey := schnorr.SerializePubKey(pubKey)
 	for _, key := range covenantKeys {
-		if pubKey.IsEqual(key) {
+		if bytes.Equal(xOnlyPubKey, schnorr.SerializePubKey(key)) {
 			return true
 		}
 	}
@@ -82,102 +176,298 @@ func outputsAreEqual(a *wire.TxOut, b *wire.TxOut) bool {
 	return true
 }
 
-// TODO: add unit tests for validations
-func (s *SignerApp) SignUnbondingTransaction(
-	ctx context.Context,
-	stakingOutputPkScript []byte,
-	unbondingTx *wire.MsgTx,
-	stakerUnbondingSig *schnorr.Signature,
-	covnentSignerPubKey *btcec.PublicKey,
-) (*schnorr.Signature, error) {
-	if err := btcstaking.CheckPreSignedUnbondingTxSanity(unbondingTx); err != nil {
+func (s *SignerApp) checkStakingTxBlockInBestChain(ctx context.Context, stakingTxInfo *TxInfo) error {
+	if stakingTxInfo.TxInclusionBlockHash == nil {
+		return fmt.Errorf("missing block hash of staking transaction")
+	}
+
+	bestChainBlockHash, err := s.r.BlockHashByHeight(ctx, stakingTxInfo.TxInclusionHeight)
+	if err != nil {
+		return err
+	}
+
+	if !bestChainBlockHash.IsEqual(stakingTxInfo.TxInclusionBlockHash) {
+		return wrapStakingTxReorgedError(fmt.Errorf(
+			"staking tx block %s at height %d was replaced by block %s",
+			stakingTxInfo.TxInclusionBlockHash.String(),
+			stakingTxInfo.TxInclusionHeight,
+			bestChainBlockHash.String(),
+		))
+	}
+
+	return nil
+}
+
+// Phase2StakingData describes phase-2 staking transaction. Contrary to phase-1
+// staking transactions, phase-2 staking transactions do not have OP_RETURN output,
+// so this data can't be parsed from the transaction and must be provided by
+// the requester. It is verified by re-building the staking output.
+type Phase2StakingData struct {
+	StakerPublicKey            *btcec.PublicKey
+	FinalityProviderPublicKeys []*btcec.PublicKey
+	StakingTime                uint16
+}
+
+// stakingTxData is data of staking transaction required to validate unbonding
+// request, regardless of the staking transaction kind
+type stakingTxData struct {
+	stakerPublicKey            *btcec.PublicKey
+	finalityProviderPublicKeys []*btcec.PublicKey
+	stakingTime                uint16
+	stakingOutput              *wire.TxOut
+	stakingOutputIdx           uint32
+	stakingInfo                *btcstaking.StakingInfo
+	txInfo                     *TxInfo
+	params                     *BabylonParams
+	confirmations              int64
+}
+
+func (s *SignerApp) parsePhase1StakingTx(
+	stakingTx *wire.MsgTx,
+	params *BabylonParams,
+) (*stakingTxData, error) {
+	parsedStakingTransaction, err := btcstaking.ParseV0StakingTx(
+		stakingTx,
+		params.MagicBytes,
+		params.CovenantPublicKeys,
+		params.CovenantQuorum,
+		s.net)
+
+	if err != nil {
 		return nil, wrapInvalidSigningRequestError(err)
 	}
 
-	script, err := txscript.ParsePkScript(stakingOutputPkScript)
+	fpKeys := []*btcec.PublicKey{parsedStakingTransaction.OpReturnData.FinalityProviderPublicKey.PubKey}
+
+	stakingInfo, err := btcstaking.BuildStakingInfo(
+		parsedStakingTransaction.OpReturnData.StakerPublicKey.PubKey,
+		fpKeys,
+		params.CovenantPublicKeys,
+		params.CovenantQuorum,
+		parsedStakingTransaction.OpReturnData.StakingTime,
+		btcutil.Amount(parsedStakingTransaction.StakingOutput.Value),
+		s.net,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	//#nosec G115 -- safe conversion from int to uint32, as this point we know that
+	// - staking transaction is valid BTC transaction that is part of the BTC ledger
+	// - BTC transactions won't have more that math.MaxUint32 outputs (in reality the max is closer to ~4k output)
+	stakingOutputIdx := uint32(parsedStakingTransaction.StakingOutputIdx)
+
+	return &stakingTxData{
+		stakerPublicKey:            parsedStakingTransaction.OpReturnData.StakerPublicKey.PubKey,
+		finalityProviderPublicKeys: fpKeys,
+		stakingTime:                parsedStakingTransaction.OpReturnData.StakingTime,
+		stakingOutput:              parsedStakingTransaction.StakingOutput,
+		stakingOutputIdx:           stakingOutputIdx,
+		stakingInfo:                stakingInfo,
+	}, nil
+}
+
+func (s *SignerApp) parsePhase2StakingTx(
+	stakingTx *wire.MsgTx,
+	stakingOutputIdx uint32,
+	data *Phase2StakingData,
+	params *BabylonParams,
+) (*stakingTxData, error) {
+	if data.StakerPublicKey == nil {
+		return nil, wrapInvalidSigningRequestError(fmt.Errorf("missing staker public key"))
+	}
+
+	if len(data.FinalityProviderPublicKeys) == 0 {
+		return nil, wrapInvalidSigningRequestError(fmt.Errorf("at least one finality provider public key is required"))
+	}
+
+	if int(stakingOutputIdx) >= len(stakingTx.TxOut) {
+		return nil, wrapInvalidSigningRequestError(fmt.Errorf("transaction has invalid input index"))
+	}
+
+	stakingOutput := stakingTx.TxOut[stakingOutputIdx]
+
+	// Re-build staking output from the data provided in the request. If it matches
+	// the output on chain, the provided data is the data staking tx was created with.
+	stakingInfo, err := btcstaking.BuildStakingInfo(
+		data.StakerPublicKey,
+		data.FinalityProviderPublicKeys,
+		params.CovenantPublicKeys,
+		params.CovenantQuorum,
+		data.StakingTime,
+		btcutil.Amount(stakingOutput.Value),
+		s.net,
+	)
 
 	if err != nil {
 		return nil, wrapInvalidSigningRequestError(err)
 	}
 
-	if script.Class() != txscript.WitnessV1TaprootTy {
-		return nil, wrapInvalidSigningRequestError(fmt.Errorf("invalid staking output pk script"))
+	if !outputsAreEqual(stakingInfo.StakingOutput, stakingOutput) {
+		return nil, wrapInvalidSigningRequestError(
+			fmt.Errorf("staking output built from provided staking data does not match staking output on chain"),
+		)
+	}
+
+	return &stakingTxData{
+		stakerPublicKey:            data.StakerPublicKey,
+		finalityProviderPublicKeys: data.FinalityProviderPublicKeys,
+		stakingTime:                data.StakingTime,
+		stakingOutput:              stakingOutput,
+		stakingOutputIdx:           stakingOutputIdx,
+		stakingInfo:                stakingInfo,
+	}, nil
+}
+
+// validateStakingTx retrieves staking transaction spent by the provided outpoint
+// from btc chain and validates it against the Babylon params applicable at its
+// inclusion height. It is shared by all flows which sign transactions spending
+// staking output.
+// If report is not nil, results of all checks are recorded in it and checks
+// which do not prevent following checks do not stop the validation.
+func (s *SignerApp) validateStakingTx(
+	ctx context.Context,
+	report *UnbondingValidationReport,
+	stakingOutPoint *wire.OutPoint,
+	stakingOutputPkScript []byte,
+	covenantSignerPubKey *btcec.PublicKey,
+	phase2Data *Phase2StakingData,
+) (*stakingTxData, error) {
+	script, err := txscript.ParsePkScript(stakingOutputPkScript)
+
+	if err == nil && script.Class() != txscript.WitnessV1TaprootTy {
+		err = fmt.Errorf("invalid staking output pk script")
+	}
+
+	if err != nil {
+		return nil, report.require(CheckStakingOutputPkScript, wrapInvalidSigningRequestError(err))
 	}
+	_ = report.check(CheckStakingOutputPkScript, nil)
 
-	stakingTxHash := unbondingTx.TxIn[0].PreviousOutPoint.Hash
+	stakingTxHash := stakingOutPoint.Hash
 
 	stakingTxInfo, err := s.r.TxByHash(ctx, &stakingTxHash, stakingOutputPkScript)
 
+	if err != nil && !errors.Is(err, ErrTxNotInChain) {
+		// failure of the chain backend is not result of the check
+		return nil, fmt.Errorf("failed to retrieve staking transaction: %w", err)
+	}
+
 	if err != nil {
+		err = wrapInvalidSigningRequestError(err)
+	}
+
+	if err = report.require(CheckStakingTxInChain, err); err != nil {
 		return nil, err
 	}
 
+	if report != nil {
+		report.StakingTxInclusionHeight = stakingTxInfo.TxInclusionHeight
+	}
+
 	if stakingTxInfo.TxInclusionHeight > s.cfg.MaxStakingTransactionHeight {
-		return nil, wrapInvalidSigningRequestError(fmt.Errorf("staking transaction is inlcluded to late in btc. Max allowed height is %d, but staking tx height is %d",
+		err = wrapInvalidSigningRequestError(fmt.Errorf("staking transaction is inlcluded to late in btc. Max allowed height is %d, but staking tx height is %d",
 			s.cfg.MaxStakingTransactionHeight,
 			stakingTxInfo.TxInclusionHeight,
 		))
 	}
 
+	if err = report.check(CheckStakingTxHeight, err); err != nil {
+		return nil, err
+	}
+
 	bestBlock, err := s.r.BestBlockHeight(ctx)
 
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: This should probably be done when service is started, otherwise if we implement
-	// retrieving params from service we will call it for every signing request
+	// All params retrievers cache params versions, so this does not require
+	// any network call
 	params, err := s.p.ParamsByHeight(ctx, uint64(stakingTxInfo.TxInclusionHeight))
 
-	if err != nil {
+	if err = report.require(CheckParams, err); err != nil {
 		return nil, err
 	}
+	s.committees.add(params.CovenantPublicKeys)
+
+	if report != nil {
+		report.ParamsVersion = params.Version
+	}
 
-	if !isCovenantMember(covnentSignerPubKey, params.CovenantPublicKeys) {
-		return nil, wrapInvalidSigningRequestError(fmt.Errorf("received covenant public key %s is not committee member at height %d",
-			hex.EncodeToString(covnentSignerPubKey.SerializeCompressed()),
+	if !isCovenantMember(covenantSignerPubKey, params.CovenantPublicKeys) {
+		err = wrapInvalidSigningRequestError(fmt.Errorf("received covenant public key %s is not committee member at height %d",
+			hex.EncodeToString(covenantSignerPubKey.SerializeCompressed()),
 			stakingTxInfo.TxInclusionHeight,
 		))
 	}
 
+	if err = report.check(CheckCovenantMember, err); err != nil {
+		return nil, err
+	}
+
 	// We are using signed numbers here as calls to:
 	// - TxByHash
 	// - BestBlockHeight
 	// are not atomic. This means if we do them during underlying node re-org
 	// we may hit the case where stakingTxInfo.TxInclusionHeight is higher than bestBlock.
+	// Such re-orgs are detected by checking staking tx block hash just before signing.
 	numberOfStakingTxConfirmations := (int64(bestBlock) - int64(stakingTxInfo.TxInclusionHeight)) + 1
 
+	if report != nil {
+		report.Confirmations = numberOfStakingTxConfirmations
+	}
+
 	if numberOfStakingTxConfirmations < int64(params.ConfirmationDepth) {
-		return nil, wrapInvalidSigningRequestError(fmt.Errorf(
+		err = wrapInvalidSigningRequestError(fmt.Errorf(
 			"staking tx does not have enough confirmations. Current confirmations: %d, required confirmations: %d",
 			numberOfStakingTxConfirmations,
 			params.ConfirmationDepth,
 		))
 	}
 
-	parsedStakingTransaction, err := btcstaking.ParseV0StakingTx(
-		stakingTxInfo.Tx,
-		params.MagicBytes,
-		params.CovenantPublicKeys,
-		params.CovenantQuorum,
-		s.net)
+	if err = report.check(CheckConfirmations, err); err != nil {
+		return nil, err
+	}
 
-	if err != nil {
-		return nil, wrapInvalidSigningRequestError(err)
+	var stakingTx *stakingTxData
+	if phase2Data == nil {
+		stakingTx, err = s.parsePhase1StakingTx(stakingTxInfo.Tx, params)
+	} else {
+		stakingTx, err = s.parsePhase2StakingTx(stakingTxInfo.Tx, stakingOutPoint.Index, phase2Data, params)
 	}
 
-	stakingOutputIndexFromUnbondingTx := unbondingTx.TxIn[0].PreviousOutPoint.Index
+	if err = report.require(CheckStakingTxParsing, err); err != nil {
+		return nil, err
+	}
 
-	//#nosec G115 -- safe conversion from int to uint32, as this point we know that
-	// - staking transaction is valid BTC transaction that is part of the BTC ledger
-	// - BTC transactions won't have more that math.MaxUint32 outputs (in reality the max is closer to ~4k output)
-	if stakingOutputIndexFromUnbondingTx != uint32(parsedStakingTransaction.StakingOutputIdx) {
-		return nil, wrapInvalidSigningRequestError(fmt.Errorf("unbonding transaction has invalid input index"))
+	if report != nil {
+		report.StakerPublicKey = stakingTx.stakerPublicKey
+		report.FinalityProviderPublicKeys = stakingTx.finalityProviderPublicKeys
+		report.StakingTime = stakingTx.stakingTime
+		report.StakingAmount = btcutil.Amount(stakingTx.stakingOutput.Value)
 	}
 
-	if parsedStakingTransaction.OpReturnData.StakingTime < params.MinStakingTime ||
-		parsedStakingTransaction.OpReturnData.StakingTime > params.MaxStakingTime {
-		return nil, wrapInvalidSigningRequestError(
+	if stakingOutPoint.Index != stakingTx.stakingOutputIdx {
+		err = wrapInvalidSigningRequestError(fmt.Errorf("transaction has invalid input index"))
+	}
+
+	if err = report.check(CheckStakingOutputIndex, err); err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(stakingTx.stakingOutput.PkScript, stakingOutputPkScript) {
+		err = wrapInvalidSigningRequestError(fmt.Errorf("staking output pk script does not match staking output on chain"))
+	}
+
+	if err = report.check(CheckStakingOutputMatch, err); err != nil {
+		return nil, err
+	}
+
+	if stakingTx.stakingTime < params.MinStakingTime ||
+		stakingTx.stakingTime > params.MaxStakingTime {
+		err = wrapInvalidSigningRequestError(
 			fmt.Errorf(
 				"staking time of staking tx with hash: %s is out of bounds",
 				stakingTxHash.String(),
@@ -185,63 +475,169 @@ func (s *SignerApp) SignUnbondingTransaction(
 		)
 	}
 
-	if parsedStakingTransaction.StakingOutput.Value < int64(params.MinStakingAmount) ||
-		parsedStakingTransaction.StakingOutput.Value > int64(params.MaxStakingAmount) {
-		return nil, wrapInvalidSigningRequestError(fmt.Errorf(
+	if err = report.check(CheckStakingTime, err); err != nil {
+		return nil, err
+	}
+
+	if stakingTx.stakingOutput.Value < int64(params.MinStakingAmount) ||
+		stakingTx.stakingOutput.Value > int64(params.MaxStakingAmount) {
+		err = wrapInvalidSigningRequestError(fmt.Errorf(
 			"staking amount of staking tx with hash: %s is out of bounds",
 			stakingTxHash.String(),
 		))
 	}
 
-	expectedUnbondingOutputValue := parsedStakingTransaction.StakingOutput.Value - int64(params.UnbondingFee)
+	if err = report.check(CheckStakingAmount, err); err != nil {
+		return nil, err
+	}
+
+	stakingTx.txInfo = stakingTxInfo
+	stakingTx.params = params
+	stakingTx.confirmations = numberOfStakingTxConfirmations
+
+	return stakingTx, nil
+}
+
+// buildUnbondingInfo builds expected unbonding output of validated staking
+// transaction
+func (s *SignerApp) buildUnbondingInfo(stakingTx *stakingTxData) (*btcstaking.UnbondingInfo, error) {
+	params := stakingTx.params
+
+	expectedUnbondingOutputValue := stakingTx.stakingOutput.Value - int64(params.UnbondingFee)
 
 	if expectedUnbondingOutputValue <= 0 {
 		// This is actually eror of our parameters configuaration and should not happen
 		// for honest requests.
-		return nil, fmt.Errorf("staking output value is too low")
+		return nil, wrapInvalidSigningRequestError(fmt.Errorf("staking output value is too low"))
 	}
 
-	// build expected output in unbonding transaction
-	unbondingInfo, err := btcstaking.BuildUnbondingInfo(
-		parsedStakingTransaction.OpReturnData.StakerPublicKey.PubKey,
-		[]*btcec.PublicKey{parsedStakingTransaction.OpReturnData.FinalityProviderPublicKey.PubKey},
+	return btcstaking.BuildUnbondingInfo(
+		stakingTx.stakerPublicKey,
+		stakingTx.finalityProviderPublicKeys,
 		params.CovenantPublicKeys,
 		params.CovenantQuorum,
 		params.UnbondingTime,
 		btcutil.Amount(expectedUnbondingOutputValue),
 		s.net,
 	)
+}
+
+// validateUnbondingTx validates unbonding transaction against staking
+// transaction it spends. It returns validated staking transaction and unbonding
+// info with the expected unbonding output.
+func (s *SignerApp) validateUnbondingTx(
+	ctx context.Context,
+	report *UnbondingValidationReport,
+	stakingOutputPkScript []byte,
+	unbondingTx *wire.MsgTx,
+	covenantSignerPubKey *btcec.PublicKey,
+	phase2Data *Phase2StakingData,
+) (*stakingTxData, *btcstaking.UnbondingInfo, error) {
+	err := btcstaking.CheckPreSignedUnbondingTxSanity(unbondingTx)
 
 	if err != nil {
-		return nil, err
+		err = wrapInvalidSigningRequestError(err)
+	}
+
+	if err = report.require(CheckUnbondingTxShape, err); err != nil {
+		return nil, nil, err
+	}
+
+	spanCtx, span := tracing.StartSpan(ctx, "validate_staking_tx")
+	stakingTx, err := s.validateStakingTx(
+		spanCtx,
+		report,
+		&unbondingTx.TxIn[0].PreviousOutPoint,
+		stakingOutputPkScript,
+		covenantSignerPubKey,
+		phase2Data,
+	)
+	span.End(err)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// expected unbonding output value is derived from the staking amount, so
+	// it can only be built if the staking amount is valid
+	if report.failed(CheckStakingAmount) {
+		return nil, nil, report.stop()
+	}
+
+	unbondingInfo, err := s.buildUnbondingInfo(stakingTx)
+
+	if err = report.require(CheckUnbondingOutput, err); err != nil {
+		return nil, nil, err
 	}
 
 	if !outputsAreEqual(unbondingInfo.UnbondingOutput, unbondingTx.TxOut[0]) {
-		return nil, wrapInvalidSigningRequestError(
+		err = wrapInvalidSigningRequestError(
 			fmt.Errorf("unbonding output does not match expected output"),
 		)
 	}
 
+	if err = report.check(CheckUnbondingOutput, err); err != nil {
+		return nil, nil, err
+	}
+
+	return stakingTx, unbondingInfo, nil
+}
+
+// validateUnbondingRequest performs all validations of unbonding signing
+// request. It returns validated staking transaction and unbonding path of
+// the staking output which is to be signed.
+func (s *SignerApp) validateUnbondingRequest(
+	ctx context.Context,
+	report *UnbondingValidationReport,
+	stakingOutputPkScript []byte,
+	unbondingTx *wire.MsgTx,
+	stakerUnbondingSig *schnorr.Signature,
+	covnentSignerPubKey *btcec.PublicKey,
+	phase2Data *Phase2StakingData,
+) (*stakingTxData, *btcstaking.SpendInfo, error) {
+	spanCtx, span := tracing.StartSpan(ctx, "validate_unbonding_tx")
+	stakingTx, _, err := s.validateUnbondingTx(
+		spanCtx,
+		report,
+		stakingOutputPkScript,
+		unbondingTx,
+		covnentSignerPubKey,
+		phase2Data,
+	)
+	span.End(err)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// At this point we know that:
 	// - unbonding tx has correct shape - 1 input, 1 output, no timelocks, not replaceable
 	// - staking tx exists on btc chain, is mature and has correct shape according Babylong Params
 	// - unbonding tx output matches the parameters from the staking transaction and the params
-	// We can send request to our remote signer
-	stakingInfo, err := btcstaking.BuildStakingInfo(
-		parsedStakingTransaction.OpReturnData.StakerPublicKey.PubKey,
-		[]*btcec.PublicKey{parsedStakingTransaction.OpReturnData.FinalityProviderPublicKey.PubKey},
-		params.CovenantPublicKeys,
-		params.CovenantQuorum,
-		parsedStakingTransaction.OpReturnData.StakingTime,
-		btcutil.Amount(parsedStakingTransaction.StakingOutput.Value),
-		s.net,
-	)
+	unbondingPathInfo, err := s.verifyStakerUnbondingSig(ctx, report, stakingTx, unbondingTx, stakerUnbondingSig)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	unbondingPathInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err := s.checkPolicy(report, stakingTx); err != nil {
+		return nil, nil, err
+	}
+
+	return stakingTx, unbondingPathInfo, nil
+}
+
+// verifyStakerUnbondingSig verifies staker signature over validated unbonding
+// transaction and checks that staking transaction was not re-orged during
+// validation. It returns unbonding path of the staking output.
+func (s *SignerApp) verifyStakerUnbondingSig(
+	ctx context.Context,
+	report *UnbondingValidationReport,
+	stakingTx *stakingTxData,
+	unbondingTx *wire.MsgTx,
+	stakerUnbondingSig *schnorr.Signature,
+) (*btcstaking.SpendInfo, error) {
+	unbondingPathInfo, err := stakingTx.stakingInfo.UnbondingPathSpendInfo()
 
 	if err != nil {
 		return nil, err
@@ -249,16 +645,18 @@ func (s *SignerApp) SignUnbondingTransaction(
 
 	// Verify that staker signature is correct. This makes sure that this is staker
 	// who requests unbonding or at least someone who has access to staker's private key
+	_, span := tracing.StartSpan(ctx, "verify_staker_signature")
 	err = btcstaking.VerifyTransactionSigWithOutput(
 		unbondingTx,
-		parsedStakingTransaction.StakingOutput,
+		stakingTx.stakingOutput,
 		unbondingPathInfo.RevealedLeaf.Script,
-		parsedStakingTransaction.OpReturnData.StakerPublicKey.PubKey,
+		stakingTx.stakerPublicKey,
 		stakerUnbondingSig.Serialize(),
 	)
+	span.End(err)
 
 	if err != nil {
-		return nil, wrapInvalidSigningRequestError(
+		err = wrapInvalidSigningRequestError(
 			fmt.Errorf(
 				"staker unbonding signature verification failed: %w",
 				err,
@@ -266,14 +664,38 @@ func (s *SignerApp) SignUnbondingTransaction(
 		)
 	}
 
+	if err = report.check(CheckStakerSignature, err); err != nil {
+		return nil, err
+	}
+
+	// Make sure that the block which included staking tx is still part of the
+	// best chain. All chain queries above are not atomic, so re-org could happen
+	// in between them.
+	spanCtx, span := tracing.StartSpan(ctx, "check_staking_tx_block")
+	err = s.checkStakingTxBlockInBestChain(spanCtx, stakingTx.txInfo)
+	span.End(err)
+
+	if err = report.check(CheckStakingTxBlockInBestChain, err); err != nil {
+		return nil, err
+	}
+
+	return unbondingPathInfo, nil
+}
+
+func (s *SignerApp) newUnbondingSigningRequest(
+	stakingTx *stakingTxData,
+	unbondingTx *wire.MsgTx,
+	unbondingPathInfo *btcstaking.SpendInfo,
+	covnentSignerPubKey *btcec.PublicKey,
+) (*SigningRequest, error) {
 	covenantKeyAddress, err := s.pubKeyToAddress(covnentSignerPubKey)
 
 	if err != nil {
 		return nil, err
 	}
 
-	sig, err := s.s.RawSignature(ctx, &SigningRequest{
-		StakingOutput:        parsedStakingTransaction.StakingOutput,
+	return &SigningRequest{
+		StakingOutput:        stakingTx.stakingOutput,
 		UnbondingTransaction: unbondingTx,
 		CovenantPublicKey:    covnentSignerPubKey,
 		CovenantAddress:      covenantKeyAddress,
@@ -281,7 +703,24 @@ func (s *SignerApp) SignUnbondingTransaction(
 			ControlBlock: &unbondingPathInfo.ControlBlock,
 			ScriptLeaf:   &unbondingPathInfo.RevealedLeaf,
 		},
-	})
+	}, nil
+}
+
+// signUnbondingTx sends validated unbonding transaction to the remote signer
+func (s *SignerApp) signUnbondingTx(
+	ctx context.Context,
+	stakingTx *stakingTxData,
+	unbondingTx *wire.MsgTx,
+	unbondingPathInfo *btcstaking.SpendInfo,
+	covnentSignerPubKey *btcec.PublicKey,
+) (*schnorr.Signature, error) {
+	req, err := s.newUnbondingSigningRequest(stakingTx, unbondingTx, unbondingPathInfo, covnentSignerPubKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := s.rawUnbondingSignature(ctx, stakingTx, req)
 
 	if err != nil {
 		return nil, err
@@ -289,3 +728,372 @@ func (s *SignerApp) SignUnbondingTransaction(
 
 	return sig.Signature, nil
 }
+
+// rawUnbondingSignature signs validated unbonding transaction with the remote
+// signer. Value of the staking output is counted towards policy cap of
+// unbonded value, unless signing fails.
+func (s *SignerApp) rawUnbondingSignature(
+	ctx context.Context,
+	stakingTx *stakingTxData,
+	req *SigningRequest,
+) (*SigningResult, error) {
+	if err := s.checkNotFrozen(); err != nil {
+		return nil, err
+	}
+
+	if s.policy == nil {
+		return s.s.RawSignature(ctx, req)
+	}
+
+	done, err := s.policy.reserveUnbondingValue(stakingTx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := s.s.RawSignature(ctx, req)
+	done(err == nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+// SignUnbondingTransaction signs unbonding transaction of phase-1 staking
+// transaction if phase2Data is nil, or of phase-2 staking transaction otherwise.
+func (s *SignerApp) SignUnbondingTransaction(
+	ctx context.Context,
+	stakingOutputPkScript []byte,
+	unbondingTx *wire.MsgTx,
+	stakerUnbondingSig *schnorr.Signature,
+	covnentSignerPubKey *btcec.PublicKey,
+	phase2Data *Phase2StakingData,
+) (*schnorr.Signature, error) {
+	spanCtx, span := tracing.StartSpan(ctx, "validate_unbonding_request")
+	stakingTx, unbondingPathInfo, err := s.validateUnbondingRequest(
+		spanCtx,
+		nil,
+		stakingOutputPkScript,
+		unbondingTx,
+		stakerUnbondingSig,
+		covnentSignerPubKey,
+		phase2Data,
+	)
+	span.End(err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	spanCtx, span = tracing.StartSpan(ctx, "sign_unbonding_tx")
+	sig, err := s.signUnbondingTx(spanCtx, stakingTx, unbondingTx, unbondingPathInfo, covnentSignerPubKey)
+	span.End(err)
+
+	return sig, err
+}
+
+// SignUnbondingTransactionByStakingOutpoint signs unbonding transaction of the
+// staking output identified by its outpoint. Unbonding transaction is built
+// from the staking transaction and params, so the caller does not need to
+// know the staking output pk script. If unbondingTx is provided, it must be
+// equal to the built unbonding transaction. Returns the built unbonding
+// transaction with the covenant signature.
+func (s *SignerApp) SignUnbondingTransactionByStakingOutpoint(
+	ctx context.Context,
+	stakingOutPoint *wire.OutPoint,
+	unbondingTx *wire.MsgTx,
+	stakerUnbondingSig *schnorr.Signature,
+	covnentSignerPubKey *btcec.PublicKey,
+	phase2Data *Phase2StakingData,
+) (*wire.MsgTx, *schnorr.Signature, error) {
+	stakingOutput, err := s.r.UnspentTxOut(ctx, stakingOutPoint)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if stakingOutput == nil {
+		return nil, nil, wrapInvalidSigningRequestError(
+			fmt.Errorf("staking output %s does not exist or is already spent", stakingOutPoint.String()),
+		)
+	}
+
+	stakingTx, err := s.validateStakingTx(
+		ctx,
+		nil,
+		stakingOutPoint,
+		stakingOutput.PkScript,
+		covnentSignerPubKey,
+		phase2Data,
+	)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unbondingInfo, err := s.buildUnbondingInfo(stakingTx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// unbonding tx has 1 input, 1 output, no timelocks and is not replaceable
+	expectedUnbondingTx := wire.NewMsgTx(2)
+	expectedUnbondingTx.AddTxIn(wire.NewTxIn(stakingOutPoint, nil, nil))
+	expectedUnbondingTx.AddTxOut(unbondingInfo.UnbondingOutput)
+
+	if unbondingTx != nil && unbondingTx.TxHash() != expectedUnbondingTx.TxHash() {
+		return nil, nil, wrapInvalidSigningRequestError(
+			fmt.Errorf("unbonding transaction does not match expected unbonding transaction %s",
+				expectedUnbondingTx.TxHash().String(),
+			),
+		)
+	}
+
+	unbondingPathInfo, err := s.verifyStakerUnbondingSig(
+		ctx,
+		nil,
+		stakingTx,
+		expectedUnbondingTx,
+		stakerUnbondingSig,
+	)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.checkPolicy(nil, stakingTx); err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := s.signUnbondingTx(ctx, stakingTx, expectedUnbondingTx, unbondingPathInfo, covnentSignerPubKey)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return expectedUnbondingTx, sig, nil
+}
+
+// ValidateUnbondingTransaction performs the same validations as
+// SignUnbondingTransaction, but does not sign the unbonding transaction.
+// Failed checks are reported in the returned report, error is returned only
+// if validation could not be done e.g when btc node is not available.
+func (s *SignerApp) ValidateUnbondingTransaction(
+	ctx context.Context,
+	stakingOutputPkScript []byte,
+	unbondingTx *wire.MsgTx,
+	stakerUnbondingSig *schnorr.Signature,
+	covnentSignerPubKey *btcec.PublicKey,
+	phase2Data *Phase2StakingData,
+) (*UnbondingValidationReport, error) {
+	report := &UnbondingValidationReport{}
+
+	_, _, err := s.validateUnbondingRequest(
+		ctx,
+		report,
+		stakingOutputPkScript,
+		unbondingTx,
+		stakerUnbondingSig,
+		covnentSignerPubKey,
+		phase2Data,
+	)
+
+	// errors of failed checks are recorded in the report
+	if err != nil && !report.aborted {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// FinalityProviderAdaptorSignature is covenant adaptor signature over slashing
+// transaction encrypted with finality provider public key. Signature can be
+// decrypted only with finality provider private key, which leaks when finality
+// provider double signs.
+type FinalityProviderAdaptorSignature struct {
+	FinalityProviderPublicKey *btcec.PublicKey
+	Signature                 *asig.AdaptorSignature
+}
+
+// SignSlashingTransaction creates adaptor signatures over slashing transaction
+// spending staking output, one for each finality provider of the staking
+// transaction. phase2Data must be provided for phase-2 staking transactions.
+func (s *SignerApp) SignSlashingTransaction(
+	ctx context.Context,
+	stakingOutputPkScript []byte,
+	slashingTx *wire.MsgTx,
+	covenantSignerPubKey *btcec.PublicKey,
+	phase2Data *Phase2StakingData,
+) ([]*FinalityProviderAdaptorSignature, error) {
+	if err := btcstaking.CheckPreSignedSlashingTxSanity(slashingTx); err != nil {
+		return nil, wrapInvalidSigningRequestError(err)
+	}
+
+	stakingTx, err := s.validateStakingTx(
+		ctx,
+		nil,
+		&slashingTx.TxIn[0].PreviousOutPoint,
+		stakingOutputPkScript,
+		covenantSignerPubKey,
+		phase2Data,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	slashingPathInfo, err := stakingTx.stakingInfo.SlashingPathSpendInfo()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.signSlashingTransaction(
+		ctx,
+		slashingTx,
+		stakingTx.txInfo.Tx,
+		stakingTx.stakingOutputIdx,
+		slashingPathInfo,
+		stakingTx,
+		covenantSignerPubKey,
+	)
+}
+
+// SignUnbondingSlashingTransaction creates adaptor signatures over slashing
+// transaction spending unbonding output, one for each finality provider of the
+// staking transaction. Unbonding transaction is validated in the same way as
+// in SignUnbondingTransaction, but staker signature is not required.
+func (s *SignerApp) SignUnbondingSlashingTransaction(
+	ctx context.Context,
+	stakingOutputPkScript []byte,
+	unbondingTx *wire.MsgTx,
+	slashingTx *wire.MsgTx,
+	covenantSignerPubKey *btcec.PublicKey,
+	phase2Data *Phase2StakingData,
+) ([]*FinalityProviderAdaptorSignature, error) {
+	stakingTx, unbondingInfo, err := s.validateUnbondingTx(
+		ctx,
+		nil,
+		stakingOutputPkScript,
+		unbondingTx,
+		covenantSignerPubKey,
+		phase2Data,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	slashingPathInfo, err := unbondingInfo.SlashingPathSpendInfo()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// unbonding tx has exactly one output, which is the unbonding output
+	return s.signSlashingTransaction(
+		ctx,
+		slashingTx,
+		unbondingTx,
+		0,
+		slashingPathInfo,
+		stakingTx,
+		covenantSignerPubKey,
+	)
+}
+
+// signSlashingTransaction validates slashing transaction spending the funding
+// output against slashing params, and creates adaptor signatures over it using
+// the provided slashing path
+func (s *SignerApp) signSlashingTransaction(
+	ctx context.Context,
+	slashingTx *wire.MsgTx,
+	fundingTx *wire.MsgTx,
+	fundingOutputIdx uint32,
+	slashingPathInfo *btcstaking.SpendInfo,
+	stakingTx *stakingTxData,
+	covenantSignerPubKey *btcec.PublicKey,
+) ([]*FinalityProviderAdaptorSignature, error) {
+	params := stakingTx.params
+
+	if len(params.SlashingPkScript) == 0 {
+		return nil, wrapInvalidSigningRequestError(fmt.Errorf(
+			"slashing params are not available for staking tx included at height %d",
+			stakingTx.txInfo.TxInclusionHeight,
+		))
+	}
+
+	// Change output of slashing tx is locked for unbonding time, the same as
+	// in Babylon
+	if err := btcstaking.CheckSlashingTxMatchFundingTx(
+		slashingTx,
+		fundingTx,
+		fundingOutputIdx,
+		int64(params.MinSlashingTxFee),
+		params.SlashingRate,
+		params.SlashingPkScript,
+		stakingTx.stakerPublicKey,
+		params.UnbondingTime,
+		s.net,
+	); err != nil {
+		return nil, wrapInvalidSigningRequestError(err)
+	}
+
+	encKeys := make([]*asig.EncryptionKey, 0, len(stakingTx.finalityProviderPublicKeys))
+	for _, fpKey := range stakingTx.finalityProviderPublicKeys {
+		encKey, err := asig.NewEncryptionKeyFromBTCPK(fpKey)
+
+		if err != nil {
+			return nil, wrapInvalidSigningRequestError(err)
+		}
+
+		encKeys = append(encKeys, encKey)
+	}
+
+	covenantKeyAddress, err := s.pubKeyToAddress(covenantSignerPubKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkStakingTxBlockInBestChain(ctx, stakingTx.txInfo); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkNotFrozen(); err != nil {
+		return nil, err
+	}
+
+	result, err := s.s.AdaptorSignatures(ctx, &AdaptorSigningRequest{
+		FundingOutput:     fundingTx.TxOut[fundingOutputIdx],
+		Transaction:       slashingTx,
+		CovenantPublicKey: covenantSignerPubKey,
+		CovenantAddress:   covenantKeyAddress,
+		SpendDescription: &SpendPathDescription{
+			ControlBlock: &slashingPathInfo.ControlBlock,
+			ScriptLeaf:   &slashingPathInfo.RevealedLeaf,
+		},
+		EncryptionKeys: encKeys,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Signatures) != len(encKeys) {
+		return nil, fmt.Errorf("signer returned %d adaptor signatures, expected %d", len(result.Signatures), len(encKeys))
+	}
+
+	signatures := make([]*FinalityProviderAdaptorSignature, 0, len(encKeys))
+	for i, sig := range result.Signatures {
+		signatures = append(signatures, &FinalityProviderAdaptorSignature{
+			FinalityProviderPublicKey: stakingTx.finalityProviderPublicKeys[i],
+			Signature:                 sig,
+		})
+	}
+
+	return signatures, nil
+}
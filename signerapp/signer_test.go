@@ -1,12 +1,15 @@
 package signerapp_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"math"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/babylonlabs-io/babylon/btcstaking"
+	asig "github.com/babylonlabs-io/babylon/crypto/schnorr-adaptor-signature"
 	"github.com/babylonlabs-io/covenant-signer/config"
 	"github.com/babylonlabs-io/covenant-signer/mocks"
 	"github.com/babylonlabs-io/covenant-signer/signerapp"
@@ -53,6 +56,13 @@ var (
 	parsed, _ = parser.ParseGlobalParams(&globalParams)
 
 	net = chaincfg.MainNetParams
+
+	stakingTxBlockHash = chainhash.Hash{0x01}
+
+	// p2wpkh pk script
+	slashingPkScript = append([]byte{0x00, 0x14}, make([]byte, 20)...)
+
+	paramsValidator = signerapp.NewGlobalParamsValidator(&net, math.MaxUint32)
 )
 
 type MockedDependencies struct {
@@ -66,6 +76,7 @@ type MockedDependencies struct {
 func parserParamsToBabylonParams(
 	versionedParams *parser.ParsedVersionedGlobalParams) *signerapp.BabylonParams {
 	return &signerapp.BabylonParams{
+		Version:            versionedParams.Version,
 		CovenantPublicKeys: versionedParams.CovenantPks,
 		CovenantQuorum:     versionedParams.CovenantQuorum,
 		MagicBytes:         versionedParams.Tag,
@@ -83,6 +94,8 @@ func NewMockedDependencies(t *testing.T) *MockedDependencies {
 	ctrl := gomock.NewController(t)
 	cfg := config.ParsedSignerAppConfig{
 		MaxStakingTransactionHeight: math.MaxUint32,
+		MaxBatchSize:                10,
+		BatchConcurrency:            4,
 	}
 	return &MockedDependencies{
 		pr:     mocks.NewMockBabylonParamsRetriever(ctrl),
@@ -177,12 +190,14 @@ func TestValidSigningRequest(t *testing.T) {
 		&validData.UnbondingTx.TxIn[0].PreviousOutPoint.Hash,
 		validData.StakingInfo.StakingOutput.PkScript).Return(
 		&signerapp.TxInfo{
-			Tx:                validData.StakingTransaction,
-			TxInclusionHeight: 200,
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
 		}, nil,
 	)
 	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil)
 	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(deps.params, nil)
+	deps.bi.EXPECT().BlockHashByHeight(gomock.Any(), uint32(200)).Return(&stakingTxBlockHash, nil)
 	// return staker signature from mock, as it does not matter for test correctness
 	deps.s.EXPECT().RawSignature(gomock.Any(), gomock.Any()).Return(&signerapp.SigningResult{
 		Signature: validData.UnbondingTxStakerSig,
@@ -194,6 +209,7 @@ func TestValidSigningRequest(t *testing.T) {
 		validData.UnbondingTx,
 		validData.UnbondingTxStakerSig,
 		deps.params.CovenantPublicKeys[0],
+		nil,
 	)
 
 	require.NoError(t, err)
@@ -211,8 +227,9 @@ func TestErrRequestNotCovenantMember(t *testing.T) {
 		&validData.UnbondingTx.TxIn[0].PreviousOutPoint.Hash,
 		validData.StakingInfo.StakingOutput.PkScript).Return(
 		&signerapp.TxInfo{
-			Tx:                validData.StakingTransaction,
-			TxInclusionHeight: 200,
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
 		}, nil,
 	)
 	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil)
@@ -227,11 +244,20 @@ func TestErrRequestNotCovenantMember(t *testing.T) {
 		validData.UnbondingTx,
 		validData.UnbondingTxStakerSig,
 		unknownCovenantMember.PubKey(),
+		nil,
 	)
 
 	require.Error(t, err)
 	require.Nil(t, receivedSignature)
 	require.True(t, errors.Is(err, signerapp.ErrInvalidSigningRequest))
+
+	reason, ok := signerapp.RejectionReason(err)
+	require.True(t, ok)
+	require.Equal(t, signerapp.CheckCovenantMember, reason)
+
+	// committee of used params is known, even though the request failed
+	require.False(t, signerApp.IsKnownCovenantMember(unknownCovenantMember.PubKey()))
+	require.True(t, signerApp.IsKnownCovenantMember(deps.params.CovenantPublicKeys[1]))
 }
 
 func TestErrStakingTxTooHigh(t *testing.T) {
@@ -247,8 +273,9 @@ func TestErrStakingTxTooHigh(t *testing.T) {
 		&validData.UnbondingTx.TxIn[0].PreviousOutPoint.Hash,
 		validData.StakingInfo.StakingOutput.PkScript).Return(
 		&signerapp.TxInfo{
-			Tx:                validData.StakingTransaction,
-			TxInclusionHeight: 200,
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
 		}, nil,
 	)
 
@@ -258,6 +285,527 @@ func TestErrStakingTxTooHigh(t *testing.T) {
 		validData.UnbondingTx,
 		validData.UnbondingTxStakerSig,
 		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+
+	require.Error(t, err)
+	require.Nil(t, receivedSignature)
+	require.True(t, errors.Is(err, signerapp.ErrInvalidSigningRequest))
+
+	reason, ok := signerapp.RejectionReason(err)
+	require.True(t, ok)
+	require.Equal(t, signerapp.CheckStakingTxHeight, reason)
+}
+
+func TestErrStakingTxBlockReorged(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData := NewValidTestData(t, deps.params)
+
+	deps.bi.EXPECT().TxByHash(
+		gomock.Any(),
+		&validData.UnbondingTx.TxIn[0].PreviousOutPoint.Hash,
+		validData.StakingInfo.StakingOutput.PkScript).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	)
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(deps.params, nil)
+	// Block at staking tx height was replaced, signer must not be called
+	deps.bi.EXPECT().BlockHashByHeight(gomock.Any(), uint32(200)).Return(&chainhash.Hash{0x02}, nil)
+
+	receivedSignature, err := signerApp.SignUnbondingTransaction(
+		context.Background(),
+		validData.StakingInfo.StakingOutput.PkScript,
+		validData.UnbondingTx,
+		validData.UnbondingTxStakerSig,
+		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+
+	require.Error(t, err)
+	require.Nil(t, receivedSignature)
+	require.True(t, errors.Is(err, signerapp.ErrStakingTxReorged))
+	require.False(t, errors.Is(err, signerapp.ErrInvalidSigningRequest))
+
+	reason, ok := signerapp.RejectionReason(err)
+	require.True(t, ok)
+	require.Equal(t, signerapp.CheckStakingTxBlockInBestChain, reason)
+}
+
+// NewValidPhase2TestData builds phase-2 staking transaction, without OP_RETURN
+// output, staking to multiple finality providers
+func NewValidPhase2TestData(t *testing.T, params *signerapp.BabylonParams) (*TestData, *signerapp.Phase2StakingData) {
+	stakerKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	var fpKeys []*btcec.PublicKey
+	for i := 0; i < 3; i++ {
+		fpKey, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+		fpKeys = append(fpKeys, fpKey.PubKey())
+	}
+	stakingData := &signerapp.Phase2StakingData{
+		StakerPublicKey:            stakerKey.PubKey(),
+		FinalityProviderPublicKeys: fpKeys,
+		StakingTime:                params.MinStakingTime + 1,
+	}
+
+	stakingInfo, err := btcstaking.BuildStakingInfo(
+		stakingData.StakerPublicKey,
+		stakingData.FinalityProviderPublicKeys,
+		params.CovenantPublicKeys,
+		params.CovenantQuorum,
+		stakingData.StakingTime,
+		params.MaxStakingAmount,
+		&net,
+	)
+	require.NoError(t, err)
+
+	stakingTx := wire.NewMsgTx(2)
+	stakingTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+	// staking output is not the first output
+	stakingTx.AddTxOut(wire.NewTxOut(1000, stakingInfo.StakingOutput.PkScript[:2]))
+	stakingTx.AddTxOut(stakingInfo.StakingOutput)
+
+	unbondingInfo, err := btcstaking.BuildUnbondingInfo(
+		stakingData.StakerPublicKey,
+		stakingData.FinalityProviderPublicKeys,
+		params.CovenantPublicKeys,
+		params.CovenantQuorum,
+		params.UnbondingTime,
+		btcutil.Amount(stakingInfo.StakingOutput.Value-int64(params.UnbondingFee)),
+		&net,
+	)
+	require.NoError(t, err)
+	stakingTxHash := stakingTx.TxHash()
+	unbondingTx := wire.NewMsgTx(2)
+	unbondingTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&stakingTxHash, 1), nil, nil))
+	unbondingTx.AddTxOut(unbondingInfo.UnbondingOutput)
+
+	stakingUnbondingPathInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	require.NoError(t, err)
+
+	validSig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(
+		unbondingTx,
+		stakingInfo.StakingOutput,
+		stakerKey,
+		stakingUnbondingPathInfo.RevealedLeaf,
+	)
+	require.NoError(t, err)
+
+	return &TestData{
+		StakerPrivKey:             stakerKey,
+		StakerPubKey:              stakerKey.PubKey(),
+		FinalityProviderPublicKey: fpKeys[0],
+		StakingTransaction:        stakingTx,
+		UnbondingTx:               unbondingTx,
+		UnbondingTxStakerSig:      validSig,
+	}, stakingData
+}
+
+func TestValidPhase2SigningRequest(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData, stakingData := NewValidPhase2TestData(t, deps.params)
+	stakingOutput := validData.StakingTransaction.TxOut[1]
+
+	deps.bi.EXPECT().TxByHash(
+		gomock.Any(),
+		&validData.UnbondingTx.TxIn[0].PreviousOutPoint.Hash,
+		stakingOutput.PkScript).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	)
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(deps.params, nil)
+	deps.bi.EXPECT().BlockHashByHeight(gomock.Any(), uint32(200)).Return(&stakingTxBlockHash, nil)
+	deps.s.EXPECT().RawSignature(gomock.Any(), gomock.Any()).Return(&signerapp.SigningResult{
+		Signature: validData.UnbondingTxStakerSig,
+	}, nil)
+
+	receivedSignature, err := signerApp.SignUnbondingTransaction(
+		context.Background(),
+		stakingOutput.PkScript,
+		validData.UnbondingTx,
+		validData.UnbondingTxStakerSig,
+		deps.params.CovenantPublicKeys[0],
+		stakingData,
+	)
+
+	require.NoError(t, err)
+	require.Equal(t, validData.UnbondingTxStakerSig, receivedSignature)
+}
+
+func TestErrPhase2StakingDataMismatch(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData, stakingData := NewValidPhase2TestData(t, deps.params)
+	stakingOutput := validData.StakingTransaction.TxOut[1]
+
+	deps.bi.EXPECT().TxByHash(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	).AnyTimes()
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil).AnyTimes()
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(deps.params, nil).AnyTimes()
+
+	invalidStakingData := []*signerapp.Phase2StakingData{
+		// different staking time
+		{
+			StakerPublicKey:            stakingData.StakerPublicKey,
+			FinalityProviderPublicKeys: stakingData.FinalityProviderPublicKeys,
+			StakingTime:                stakingData.StakingTime + 1,
+		},
+		// missing one of finality providers
+		{
+			StakerPublicKey:            stakingData.StakerPublicKey,
+			FinalityProviderPublicKeys: stakingData.FinalityProviderPublicKeys[1:],
+			StakingTime:                stakingData.StakingTime,
+		},
+		// different staker
+		{
+			StakerPublicKey:            stakingData.FinalityProviderPublicKeys[0],
+			FinalityProviderPublicKeys: stakingData.FinalityProviderPublicKeys[1:],
+			StakingTime:                stakingData.StakingTime,
+		},
+		// no finality providers
+		{
+			StakerPublicKey: stakingData.StakerPublicKey,
+			StakingTime:     stakingData.StakingTime,
+		},
+	}
+
+	for _, data := range invalidStakingData {
+		receivedSignature, err := signerApp.SignUnbondingTransaction(
+			context.Background(),
+			stakingOutput.PkScript,
+			validData.UnbondingTx,
+			validData.UnbondingTxStakerSig,
+			deps.params.CovenantPublicKeys[0],
+			data,
+		)
+
+		require.Error(t, err)
+		require.Nil(t, receivedSignature)
+		require.True(t, errors.Is(err, signerapp.ErrInvalidSigningRequest))
+	}
+
+	// phase-2 staking tx can't be signed as phase-1 staking tx
+	receivedSignature, err := signerApp.SignUnbondingTransaction(
+		context.Background(),
+		stakingOutput.PkScript,
+		validData.UnbondingTx,
+		validData.UnbondingTxStakerSig,
+		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+	require.Error(t, err)
+	require.Nil(t, receivedSignature)
+	require.True(t, errors.Is(err, signerapp.ErrInvalidSigningRequest))
+}
+
+func withSlashingParams(params *signerapp.BabylonParams) *signerapp.BabylonParams {
+	params.SlashingPkScript = slashingPkScript
+	params.SlashingRate = sdkmath.LegacyMustNewDecFromStr("0.1")
+	params.MinSlashingTxFee = 1000
+	return params
+}
+
+func buildSlashingTx(
+	t *testing.T,
+	params *signerapp.BabylonParams,
+	fundingTx *wire.MsgTx,
+	fundingOutputIdx uint32,
+	stakerPubKey *btcec.PublicKey,
+) *wire.MsgTx {
+	slashingTx, err := btcstaking.BuildSlashingTxFromStakingTxStrict(
+		fundingTx,
+		fundingOutputIdx,
+		params.SlashingPkScript,
+		stakerPubKey,
+		params.UnbondingTime,
+		int64(params.MinSlashingTxFee),
+		params.SlashingRate,
+		&net,
+	)
+	require.NoError(t, err)
+	return slashingTx
+}
+
+// expectAdaptorSignatures makes signer mock return valid adaptor signatures
+// for all encryption keys in the request
+func expectAdaptorSignatures(t *testing.T, deps *MockedDependencies, expectedKeys int) {
+	deps.s.EXPECT().AdaptorSignatures(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *signerapp.AdaptorSigningRequest) (*signerapp.AdaptorSigningResult, error) {
+			require.Len(t, req.EncryptionKeys, expectedKeys)
+			key, err := btcec.NewPrivateKey()
+			require.NoError(t, err)
+			var sigs []*asig.AdaptorSignature
+			for _, encKey := range req.EncryptionKeys {
+				sig, err := asig.EncSign(key, encKey, chainhash.HashB([]byte("msg")))
+				require.NoError(t, err)
+				sigs = append(sigs, sig)
+			}
+			return &signerapp.AdaptorSigningResult{Signatures: sigs}, nil
+		},
+	)
+}
+
+func TestValidSlashingSigningRequest(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	params := withSlashingParams(deps.params)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData, stakingData := NewValidPhase2TestData(t, params)
+	stakingOutput := validData.StakingTransaction.TxOut[1]
+	slashingTx := buildSlashingTx(t, params, validData.StakingTransaction, 1, validData.StakerPubKey)
+
+	deps.bi.EXPECT().TxByHash(
+		gomock.Any(),
+		&slashingTx.TxIn[0].PreviousOutPoint.Hash,
+		stakingOutput.PkScript).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	)
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(params, nil)
+	deps.bi.EXPECT().BlockHashByHeight(gomock.Any(), uint32(200)).Return(&stakingTxBlockHash, nil)
+	expectAdaptorSignatures(t, deps, len(stakingData.FinalityProviderPublicKeys))
+
+	sigs, err := signerApp.SignSlashingTransaction(
+		context.Background(),
+		stakingOutput.PkScript,
+		slashingTx,
+		params.CovenantPublicKeys[0],
+		stakingData,
+	)
+
+	require.NoError(t, err)
+	require.Len(t, sigs, len(stakingData.FinalityProviderPublicKeys))
+	for i, sig := range sigs {
+		require.True(t, sig.FinalityProviderPublicKey.IsEqual(stakingData.FinalityProviderPublicKeys[i]))
+	}
+}
+
+func TestErrInvalidSlashingSigningRequest(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	params := withSlashingParams(deps.params)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData, stakingData := NewValidPhase2TestData(t, params)
+	stakingOutput := validData.StakingTransaction.TxOut[1]
+
+	deps.bi.EXPECT().TxByHash(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	).AnyTimes()
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil).AnyTimes()
+
+	// slashing tx does not pay to slashing pk script
+	otherParams := *params
+	otherParams.SlashingPkScript = append([]byte{0x00, 0x14}, bytes.Repeat([]byte{0x01}, 20)...)
+	invalidSlashingTx := buildSlashingTx(t, &otherParams, validData.StakingTransaction, 1, validData.StakerPubKey)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(params, nil)
+
+	sigs, err := signerApp.SignSlashingTransaction(
+		context.Background(),
+		stakingOutput.PkScript,
+		invalidSlashingTx,
+		params.CovenantPublicKeys[0],
+		stakingData,
+	)
+	require.Error(t, err)
+	require.Nil(t, sigs)
+	require.True(t, errors.Is(err, signerapp.ErrInvalidSigningRequest))
+
+	// params without slashing params, like phase-1 global params
+	slashingTx := buildSlashingTx(t, params, validData.StakingTransaction, 1, validData.StakerPubKey)
+	noSlashingParams := *params
+	noSlashingParams.SlashingPkScript = nil
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(&noSlashingParams, nil)
+
+	sigs, err = signerApp.SignSlashingTransaction(
+		context.Background(),
+		stakingOutput.PkScript,
+		slashingTx,
+		params.CovenantPublicKeys[0],
+		stakingData,
+	)
+	require.Error(t, err)
+	require.Nil(t, sigs)
+	require.True(t, errors.Is(err, signerapp.ErrInvalidSigningRequest))
+}
+
+func TestValidUnbondingSlashingSigningRequest(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	params := withSlashingParams(deps.params)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData, stakingData := NewValidPhase2TestData(t, params)
+	stakingOutput := validData.StakingTransaction.TxOut[1]
+	unbondingSlashingTx := buildSlashingTx(t, params, validData.UnbondingTx, 0, validData.StakerPubKey)
+
+	deps.bi.EXPECT().TxByHash(
+		gomock.Any(),
+		&validData.UnbondingTx.TxIn[0].PreviousOutPoint.Hash,
+		stakingOutput.PkScript).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	)
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(params, nil)
+	deps.bi.EXPECT().BlockHashByHeight(gomock.Any(), uint32(200)).Return(&stakingTxBlockHash, nil)
+	expectAdaptorSignatures(t, deps, len(stakingData.FinalityProviderPublicKeys))
+
+	sigs, err := signerApp.SignUnbondingSlashingTransaction(
+		context.Background(),
+		stakingOutput.PkScript,
+		validData.UnbondingTx,
+		unbondingSlashingTx,
+		params.CovenantPublicKeys[0],
+		stakingData,
+	)
+
+	require.NoError(t, err)
+	require.Len(t, sigs, len(stakingData.FinalityProviderPublicKeys))
+}
+
+func TestErrUnbondingSlashingTxNotSpendingUnbondingOutput(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	params := withSlashingParams(deps.params)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData, stakingData := NewValidPhase2TestData(t, params)
+	stakingOutput := validData.StakingTransaction.TxOut[1]
+	// slashing tx spending staking output instead of unbonding output
+	stakingSlashingTx := buildSlashingTx(t, params, validData.StakingTransaction, 1, validData.StakerPubKey)
+
+	deps.bi.EXPECT().TxByHash(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	)
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(params, nil)
+
+	sigs, err := signerApp.SignUnbondingSlashingTransaction(
+		context.Background(),
+		stakingOutput.PkScript,
+		validData.UnbondingTx,
+		stakingSlashingTx,
+		params.CovenantPublicKeys[0],
+		stakingData,
+	)
+
+	require.Error(t, err)
+	require.Nil(t, sigs)
+	require.True(t, errors.Is(err, signerapp.ErrInvalidSigningRequest))
+}
+
+func TestValidSigningRequestByStakingOutpoint(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData := NewValidTestData(t, deps.params)
+	stakingOutPoint := validData.UnbondingTx.TxIn[0].PreviousOutPoint
+
+	deps.bi.EXPECT().UnspentTxOut(gomock.Any(), &stakingOutPoint).Return(validData.StakingInfo.StakingOutput, nil)
+	deps.bi.EXPECT().TxByHash(
+		gomock.Any(),
+		&stakingOutPoint.Hash,
+		validData.StakingInfo.StakingOutput.PkScript).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	)
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(deps.params, nil)
+	deps.bi.EXPECT().BlockHashByHeight(gomock.Any(), uint32(200)).Return(&stakingTxBlockHash, nil)
+	deps.s.EXPECT().RawSignature(gomock.Any(), gomock.Any()).Return(&signerapp.SigningResult{
+		Signature: validData.UnbondingTxStakerSig,
+	}, nil)
+
+	unbondingTx, receivedSignature, err := signerApp.SignUnbondingTransactionByStakingOutpoint(
+		context.Background(),
+		&stakingOutPoint,
+		nil,
+		validData.UnbondingTxStakerSig,
+		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+
+	require.NoError(t, err)
+	require.Equal(t, validData.UnbondingTx.TxHash(), unbondingTx.TxHash())
+	require.Equal(t, validData.UnbondingTxStakerSig, receivedSignature)
+}
+
+func TestErrUnbondingTxDoesNotMatchExpected(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData := NewValidTestData(t, deps.params)
+	stakingOutPoint := validData.UnbondingTx.TxIn[0].PreviousOutPoint
+
+	deps.bi.EXPECT().UnspentTxOut(gomock.Any(), &stakingOutPoint).Return(validData.StakingInfo.StakingOutput, nil)
+	deps.bi.EXPECT().TxByHash(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	)
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(deps.params, nil)
+
+	// unbonding tx paying more fee than required by params
+	unbondingTx := validData.UnbondingTx.Copy()
+	unbondingTx.TxOut[0].Value -= 1
+
+	_, receivedSignature, err := signerApp.SignUnbondingTransactionByStakingOutpoint(
+		context.Background(),
+		&stakingOutPoint,
+		unbondingTx,
+		validData.UnbondingTxStakerSig,
+		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+
+	require.Error(t, err)
+	require.Nil(t, receivedSignature)
+	require.True(t, errors.Is(err, signerapp.ErrInvalidSigningRequest))
+}
+
+func TestErrStakingOutputSpent(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData := NewValidTestData(t, deps.params)
+	stakingOutPoint := validData.UnbondingTx.TxIn[0].PreviousOutPoint
+
+	deps.bi.EXPECT().UnspentTxOut(gomock.Any(), &stakingOutPoint).Return(nil, nil)
+
+	_, receivedSignature, err := signerApp.SignUnbondingTransactionByStakingOutpoint(
+		context.Background(),
+		&stakingOutPoint,
+		nil,
+		validData.UnbondingTxStakerSig,
+		deps.params.CovenantPublicKeys[0],
+		nil,
 	)
 
 	require.Error(t, err)
@@ -0,0 +1,190 @@
+package signerapp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/babylonlabs-io/babylon/btcstaking"
+	"github.com/babylonlabs-io/covenant-signer/observability/tracing"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+)
+
+// checkUnbondingPsbt checks that the packet has single input with witness utxo
+// and at least one taproot script spend signature. Signatures of covenant
+// members which already signed the packet may be present besides the staker
+// signature.
+func checkUnbondingPsbt(packet *psbt.Packet) error {
+	if len(packet.Inputs) != 1 || len(packet.UnsignedTx.TxIn) != 1 {
+		return fmt.Errorf("psbt packet must have exactly one input")
+	}
+
+	input := packet.Inputs[0]
+
+	if input.WitnessUtxo == nil {
+		return fmt.Errorf("psbt packet input does not have witness utxo")
+	}
+
+	if len(input.TaprootScriptSpendSig) == 0 {
+		return fmt.Errorf("psbt packet input does not have taproot script spend signature")
+	}
+
+	return nil
+}
+
+// stakerUnbondingPsbtSig returns signature of the staker key over unbonding
+// path leaf from the packet input
+func stakerUnbondingPsbtSig(input *psbt.PInput, stakerPubKey *btcec.PublicKey, leafHash []byte) (*schnorr.Signature, error) {
+	for _, spendSig := range input.TaprootScriptSpendSig {
+		if !bytes.Equal(spendSig.XOnlyPubKey, schnorr.SerializePubKey(stakerPubKey)) ||
+			!bytes.Equal(spendSig.LeafHash, leafHash) {
+			continue
+		}
+
+		if spendSig.SigHash != txscript.SigHashDefault {
+			return nil, fmt.Errorf("staker signature must use default sighash type")
+		}
+
+		stakerSig, err := schnorr.ParseSignature(spendSig.Signature)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid staker signature: %w", err)
+		}
+
+		return stakerSig, nil
+	}
+
+	return nil, fmt.Errorf("psbt packet does not have staker signature over unbonding path of the staker key")
+}
+
+// addCovenantUnbondingPsbtSig adds covenant signature to the packet input,
+// replacing previous signature of the same covenant key. Unbonding path leaf
+// script is added if the packet does not have it, so that the packet can be
+// finalized once covenant quorum is reached.
+func addCovenantUnbondingPsbtSig(
+	input *psbt.PInput,
+	covenantSig *psbt.TaprootScriptSpendSig,
+	unbondingPathInfo *btcstaking.SpendInfo,
+) error {
+	spendSigs := make([]*psbt.TaprootScriptSpendSig, 0, len(input.TaprootScriptSpendSig)+1)
+	for _, spendSig := range input.TaprootScriptSpendSig {
+		if bytes.Equal(spendSig.XOnlyPubKey, covenantSig.XOnlyPubKey) &&
+			bytes.Equal(spendSig.LeafHash, covenantSig.LeafHash) {
+			continue
+		}
+		spendSigs = append(spendSigs, spendSig)
+	}
+	input.TaprootScriptSpendSig = append(spendSigs, covenantSig)
+
+	for _, leafScript := range input.TaprootLeafScript {
+		if bytes.Equal(leafScript.Script, unbondingPathInfo.RevealedLeaf.Script) {
+			return nil
+		}
+	}
+
+	ctrlBlockBytes, err := unbondingPathInfo.ControlBlock.ToBytes()
+
+	if err != nil {
+		return fmt.Errorf("failed to serialize control block: %w", err)
+	}
+
+	input.TaprootLeafScript = append(input.TaprootLeafScript, &psbt.TaprootTapLeafScript{
+		ControlBlock: ctrlBlockBytes,
+		Script:       unbondingPathInfo.RevealedLeaf.Script,
+		LeafVersion:  unbondingPathInfo.RevealedLeaf.LeafVersion,
+	})
+
+	return nil
+}
+
+// SignUnbondingPsbt signs unbonding transaction from the PSBT packet. Packet
+// must contain the unbonding transaction, the staking output as witness utxo
+// and the staker signature over unbonding path of the staking output. Request
+// is validated in the same way as in SignUnbondingTransaction. Covenant
+// signature is added to the received packet, so that all other data of the
+// packet, including signatures of other covenant members, are preserved.
+func (s *SignerApp) SignUnbondingPsbt(
+	ctx context.Context,
+	packet *psbt.Packet,
+	covnentSignerPubKey *btcec.PublicKey,
+	phase2Data *Phase2StakingData,
+) (*psbt.Packet, error) {
+	if err := checkUnbondingPsbt(packet); err != nil {
+		return nil, wrapInvalidSigningRequestError(err)
+	}
+
+	unbondingTx := packet.UnsignedTx
+	input := &packet.Inputs[0]
+
+	// staker signature can be found only once the staker key is known from
+	// validated staking transaction, so the steps of validateUnbondingRequest
+	// are done one by one
+	spanCtx, span := tracing.StartSpan(ctx, "validate_unbonding_tx")
+	stakingTx, _, err := s.validateUnbondingTx(
+		spanCtx,
+		nil,
+		input.WitnessUtxo.PkScript,
+		unbondingTx,
+		covnentSignerPubKey,
+		phase2Data,
+	)
+	span.End(err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !outputsAreEqual(input.WitnessUtxo, stakingTx.stakingOutput) {
+		return nil, wrapInvalidSigningRequestError(fmt.Errorf("witness utxo does not match staking output"))
+	}
+
+	unbondingPathInfo, err := stakingTx.stakingInfo.UnbondingPathSpendInfo()
+
+	if err != nil {
+		return nil, err
+	}
+
+	leafHash := unbondingPathInfo.RevealedLeaf.TapHash()
+
+	stakerSig, err := stakerUnbondingPsbtSig(input, stakingTx.stakerPublicKey, leafHash[:])
+
+	if err != nil {
+		return nil, wrapInvalidSigningRequestError(err)
+	}
+
+	if _, err := s.verifyStakerUnbondingSig(ctx, nil, stakingTx, unbondingTx, stakerSig); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkPolicy(nil, stakingTx); err != nil {
+		return nil, err
+	}
+
+	req, err := s.newUnbondingSigningRequest(stakingTx, unbondingTx, unbondingPathInfo, covnentSignerPubKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := s.rawUnbondingSignature(ctx, stakingTx, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = addCovenantUnbondingPsbtSig(input, &psbt.TaprootScriptSpendSig{
+		XOnlyPubKey: schnorr.SerializePubKey(covnentSignerPubKey),
+		LeafHash:    leafHash[:],
+		Signature:   sig.Signature.Serialize(),
+		SigHash:     txscript.SigHashDefault,
+	}, unbondingPathInfo)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return packet, nil
+}
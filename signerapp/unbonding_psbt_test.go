@@ -0,0 +1,193 @@
+package signerapp_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func newStakerUnbondingPsbt(t *testing.T, data *TestData) *psbt.Packet {
+	packet, err := psbt.NewFromUnsignedTx(data.UnbondingTx.Copy())
+	require.NoError(t, err)
+
+	unbondingPathInfo, err := data.StakingInfo.UnbondingPathSpendInfo()
+	require.NoError(t, err)
+	leafHash := unbondingPathInfo.RevealedLeaf.TapHash()
+
+	packet.Inputs[0].WitnessUtxo = wire.NewTxOut(
+		data.StakingInfo.StakingOutput.Value,
+		data.StakingInfo.StakingOutput.PkScript,
+	)
+	packet.Inputs[0].TaprootScriptSpendSig = []*psbt.TaprootScriptSpendSig{
+		{
+			XOnlyPubKey: schnorr.SerializePubKey(data.StakerPubKey),
+			LeafHash:    leafHash[:],
+			Signature:   data.UnbondingTxStakerSig.Serialize(),
+			SigHash:     txscript.SigHashDefault,
+		},
+	}
+
+	return packet
+}
+
+// expectValidStakingTxChainState expects retrieval of mature staking tx
+func expectValidStakingTxChainState(deps *MockedDependencies, data *TestData) {
+	deps.bi.EXPECT().TxByHash(
+		gomock.Any(),
+		&data.UnbondingTx.TxIn[0].PreviousOutPoint.Hash,
+		data.StakingInfo.StakingOutput.PkScript).Return(
+		&signerapp.TxInfo{
+			Tx:                   data.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	)
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(deps.params, nil)
+}
+
+func expectValidUnbondingChainState(deps *MockedDependencies, data *TestData) {
+	expectValidStakingTxChainState(deps, data)
+	deps.bi.EXPECT().BlockHashByHeight(gomock.Any(), uint32(200)).Return(&stakingTxBlockHash, nil)
+}
+
+func TestValidUnbondingPsbtSigningRequest(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData := NewValidTestData(t, deps.params)
+	packet := newStakerUnbondingPsbt(t, validData)
+	covenantPublicKey := deps.params.CovenantPublicKeys[0]
+
+	expectValidUnbondingChainState(deps, validData)
+	// return staker signature from mock, as it does not matter for test correctness
+	deps.s.EXPECT().RawSignature(gomock.Any(), gomock.Any()).Return(&signerapp.SigningResult{
+		Signature: validData.UnbondingTxStakerSig,
+	}, nil)
+
+	signedPacket, err := signerApp.SignUnbondingPsbt(
+		context.Background(),
+		packet,
+		covenantPublicKey,
+		nil,
+	)
+
+	require.NoError(t, err)
+	require.Equal(t, validData.UnbondingTx.TxHash(), signedPacket.UnsignedTx.TxHash())
+	require.Len(t, signedPacket.Inputs[0].TaprootLeafScript, 1)
+
+	sigs := signedPacket.Inputs[0].TaprootScriptSpendSig
+	require.Len(t, sigs, 2)
+	require.Equal(t, packet.Inputs[0].TaprootScriptSpendSig[0], sigs[0])
+	require.Equal(t, schnorr.SerializePubKey(covenantPublicKey), sigs[1].XOnlyPubKey)
+	require.Equal(t, sigs[0].LeafHash, sigs[1].LeafHash)
+	require.Equal(t, validData.UnbondingTxStakerSig.Serialize(), sigs[1].Signature)
+
+	_, err = signedPacket.B64Encode()
+	require.NoError(t, err)
+}
+
+func TestUnbondingPsbtSigningPreservesPacket(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData := NewValidTestData(t, deps.params)
+	covenantPublicKey := deps.params.CovenantPublicKeys[0]
+
+	// packet already signed by other covenant member, with data unknown to
+	// the signer
+	packet := newStakerUnbondingPsbt(t, validData)
+	stakerSpendSig := packet.Inputs[0].TaprootScriptSpendSig[0]
+	otherMemberSpendSig := &psbt.TaprootScriptSpendSig{
+		XOnlyPubKey: schnorr.SerializePubKey(deps.params.CovenantPublicKeys[1]),
+		LeafHash:    stakerSpendSig.LeafHash,
+		Signature:   validData.UnbondingTxStakerSig.Serialize(),
+		SigHash:     txscript.SigHashDefault,
+	}
+	packet.Inputs[0].TaprootScriptSpendSig = append(packet.Inputs[0].TaprootScriptSpendSig, otherMemberSpendSig)
+	unknown := &psbt.Unknown{Key: []byte{0xfc, 0x01}, Value: []byte("proprietary")}
+	packet.Unknowns = []*psbt.Unknown{unknown}
+	packet.Inputs[0].Unknowns = []*psbt.Unknown{unknown}
+
+	// packet is sent to the signer serialized
+	encoded, err := packet.B64Encode()
+	require.NoError(t, err)
+	received, err := psbt.NewFromRawBytes(strings.NewReader(encoded), true)
+	require.NoError(t, err)
+
+	expectValidUnbondingChainState(deps, validData)
+	deps.s.EXPECT().RawSignature(gomock.Any(), gomock.Any()).Return(&signerapp.SigningResult{
+		Signature: validData.UnbondingTxStakerSig,
+	}, nil)
+
+	signedPacket, err := signerApp.SignUnbondingPsbt(context.Background(), received, covenantPublicKey, nil)
+	require.NoError(t, err)
+
+	encoded, err = signedPacket.B64Encode()
+	require.NoError(t, err)
+	decoded, err := psbt.NewFromRawBytes(strings.NewReader(encoded), true)
+	require.NoError(t, err)
+
+	require.Equal(t, []*psbt.Unknown{unknown}, decoded.Unknowns)
+	require.Equal(t, []*psbt.Unknown{unknown}, decoded.Inputs[0].Unknowns)
+	require.Len(t, decoded.Inputs[0].TaprootLeafScript, 1)
+
+	sigs := decoded.Inputs[0].TaprootScriptSpendSig
+	require.Len(t, sigs, 3)
+	require.Contains(t, sigs, stakerSpendSig)
+	require.Contains(t, sigs, otherMemberSpendSig)
+	require.Contains(t, sigs, &psbt.TaprootScriptSpendSig{
+		XOnlyPubKey: schnorr.SerializePubKey(covenantPublicKey),
+		LeafHash:    stakerSpendSig.LeafHash,
+		Signature:   validData.UnbondingTxStakerSig.Serialize(),
+		SigHash:     txscript.SigHashDefault,
+	})
+}
+
+func TestErrUnbondingPsbtSignatureNotFromStaker(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData := NewValidTestData(t, deps.params)
+	packet := newStakerUnbondingPsbt(t, validData)
+	packet.Inputs[0].TaprootScriptSpendSig[0].XOnlyPubKey = schnorr.SerializePubKey(validData.FinalityProviderPublicKey)
+
+	// staker signature is looked up before the staker signature is verified
+	expectValidStakingTxChainState(deps, validData)
+
+	signedPacket, err := signerApp.SignUnbondingPsbt(
+		context.Background(),
+		packet,
+		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+
+	require.Error(t, err)
+	require.Nil(t, signedPacket)
+	require.True(t, errors.Is(err, signerapp.ErrInvalidSigningRequest))
+}
+
+func TestErrUnbondingPsbtWithoutWitnessUtxo(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData := NewValidTestData(t, deps.params)
+	packet := newStakerUnbondingPsbt(t, validData)
+	packet.Inputs[0].WitnessUtxo = nil
+
+	signedPacket, err := signerApp.SignUnbondingPsbt(
+		context.Background(),
+		packet,
+		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+
+	require.Error(t, err)
+	require.Nil(t, signedPacket)
+	require.True(t, errors.Is(err, signerapp.ErrInvalidSigningRequest))
+}
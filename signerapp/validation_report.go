@@ -0,0 +1,168 @@
+package signerapp
+
+import (
+	"errors"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// Names of checks reported by dry-run validation
+const (
+	CheckUnbondingTxShape          = "unbonding_tx_shape"
+	CheckStakingOutputPkScript     = "staking_output_pk_script"
+	CheckStakingTxInChain          = "staking_tx_in_chain"
+	CheckStakingTxHeight           = "staking_tx_height"
+	CheckParams                    = "params"
+	CheckCovenantMember            = "covenant_member"
+	CheckConfirmations             = "confirmations"
+	CheckStakingTxParsing          = "staking_tx_parsing"
+	CheckStakingOutputIndex        = "staking_output_index"
+	CheckStakingOutputMatch        = "staking_output_match"
+	CheckStakingTime               = "staking_time"
+	CheckStakingAmount             = "staking_amount"
+	CheckUnbondingOutput           = "unbonding_output"
+	CheckStakerSignature           = "staker_signature"
+	CheckStakingTxBlockInBestChain = "staking_tx_block_in_best_chain"
+)
+
+// RejectionInvalidRequest is rejection reason of invalid requests which did not
+// fail any of the named checks
+const RejectionInvalidRequest = "invalid_request"
+
+// CheckError is error of the named check which failed while signing
+type CheckError struct {
+	Check string
+	Err   error
+}
+
+func (e *CheckError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *CheckError) Unwrap() error {
+	return e.Err
+}
+
+func newCheckError(name string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &CheckError{Check: name, Err: err}
+}
+
+// RejectionReason returns reason why the signing request was rejected, false
+// if the error is not rejection of the request e.g. network error. Reason is
+// name of the failed check if it is known.
+func RejectionReason(err error) (string, bool) {
+	if !errors.Is(err, ErrInvalidSigningRequest) && !errors.Is(err, ErrStakingTxReorged) {
+		return "", false
+	}
+
+	var checkErr *CheckError
+	if errors.As(err, &checkErr) {
+		return checkErr.Check, true
+	}
+
+	return RejectionInvalidRequest, true
+}
+
+// ValidationCheck is result of single check done during dry-run validation
+type ValidationCheck struct {
+	Name   string
+	Passed bool
+	// Err describes why the check failed, nil if the check passed
+	Err error
+}
+
+// UnbondingValidationReport is result of dry-run validation of unbonding
+// request. Staking data is set only if it was successfully retrieved.
+type UnbondingValidationReport struct {
+	StakerPublicKey            *btcec.PublicKey
+	FinalityProviderPublicKeys []*btcec.PublicKey
+	StakingTime                uint16
+	StakingAmount              btcutil.Amount
+	StakingTxInclusionHeight   uint32
+	ParamsVersion              uint64
+	Confirmations              int64
+	// Checks in the order they were done. If check failed in a way which makes
+	// following checks impossible, the validation stops and following checks
+	// are not reported.
+	Checks []*ValidationCheck
+
+	// aborted is set when validation stopped on failed check
+	aborted bool
+}
+
+// Valid returns true if all checks were done and passed
+func (r *UnbondingValidationReport) Valid() bool {
+	if r.aborted || len(r.Checks) == 0 {
+		return false
+	}
+
+	for _, c := range r.Checks {
+		if !c.Passed {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *UnbondingValidationReport) record(name string, err error) {
+	r.Checks = append(r.Checks, &ValidationCheck{
+		Name:   name,
+		Passed: err == nil,
+		Err:    err,
+	})
+}
+
+// check records result of the check which does not prevent following checks.
+// While signing, report is nil and the error is returned as CheckError to stop
+// signing. During dry-run validation, failure is recorded and validation
+// continues.
+func (r *UnbondingValidationReport) check(name string, err error) error {
+	if r == nil {
+		return newCheckError(name, err)
+	}
+
+	r.record(name, err)
+	return nil
+}
+
+// failed returns true if the check was recorded as failed. While signing,
+// report is nil and failed check already stopped signing.
+func (r *UnbondingValidationReport) failed(name string) bool {
+	if r == nil {
+		return false
+	}
+
+	for _, c := range r.Checks {
+		if c.Name == name && !c.Passed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stop stops validation after failed check as following checks are impossible
+func (r *UnbondingValidationReport) stop() error {
+	r.aborted = true
+	return errValidationStopped
+}
+
+// require records result of the check which must pass for following checks
+// to be possible. Error is always returned.
+func (r *UnbondingValidationReport) require(name string, err error) error {
+	if r == nil {
+		return newCheckError(name, err)
+	}
+
+	r.record(name, err)
+	if err != nil {
+		r.aborted = true
+	}
+	return err
+}
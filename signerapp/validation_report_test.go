@@ -0,0 +1,269 @@
+package signerapp_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func checksByName(report *signerapp.UnbondingValidationReport) map[string]*signerapp.ValidationCheck {
+	checks := make(map[string]*signerapp.ValidationCheck, len(report.Checks))
+	for _, c := range report.Checks {
+		checks[c.Name] = c
+	}
+	return checks
+}
+
+func TestValidateValidUnbondingRequest(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData := NewValidTestData(t, deps.params)
+
+	params := *deps.params
+	params.Version = 3
+
+	deps.bi.EXPECT().TxByHash(
+		gomock.Any(),
+		&validData.UnbondingTx.TxIn[0].PreviousOutPoint.Hash,
+		validData.StakingInfo.StakingOutput.PkScript).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	)
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(&params, nil)
+	deps.bi.EXPECT().BlockHashByHeight(gomock.Any(), uint32(200)).Return(&stakingTxBlockHash, nil)
+	// RawSignature is not expected, dry-run must not sign
+
+	report, err := signerApp.ValidateUnbondingTransaction(
+		context.Background(),
+		validData.StakingInfo.StakingOutput.PkScript,
+		validData.UnbondingTx,
+		validData.UnbondingTxStakerSig,
+		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+
+	require.NoError(t, err)
+	require.True(t, report.Valid())
+	require.Equal(t, uint64(3), report.ParamsVersion)
+	require.Equal(t, int64(101), report.Confirmations)
+	require.Equal(t, uint32(200), report.StakingTxInclusionHeight)
+	require.Equal(t, btcutil.Amount(validData.StakingInfo.StakingOutput.Value), report.StakingAmount)
+	require.Equal(t, deps.params.MinStakingTime+1, report.StakingTime)
+	// keys parsed from staking tx are x-only keys
+	require.Equal(t,
+		schnorr.SerializePubKey(validData.StakerPubKey),
+		schnorr.SerializePubKey(report.StakerPublicKey),
+	)
+	require.Len(t, report.FinalityProviderPublicKeys, 1)
+	require.Equal(t,
+		schnorr.SerializePubKey(validData.FinalityProviderPublicKey),
+		schnorr.SerializePubKey(report.FinalityProviderPublicKeys[0]),
+	)
+
+	checks := checksByName(report)
+	for _, name := range []string{
+		signerapp.CheckUnbondingTxShape,
+		signerapp.CheckCovenantMember,
+		signerapp.CheckConfirmations,
+		signerapp.CheckUnbondingOutput,
+		signerapp.CheckStakerSignature,
+		signerapp.CheckStakingTxBlockInBestChain,
+	} {
+		require.Contains(t, checks, name)
+		require.True(t, checks[name].Passed)
+	}
+}
+
+func TestValidateReportsAllFailedChecks(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData := NewValidTestData(t, deps.params)
+
+	deps.bi.EXPECT().TxByHash(
+		gomock.Any(),
+		&validData.UnbondingTx.TxIn[0].PreviousOutPoint.Hash,
+		validData.StakingInfo.StakingOutput.PkScript).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	)
+	// staking tx has only 1 confirmation
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(200), nil)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(deps.params, nil)
+	deps.bi.EXPECT().BlockHashByHeight(gomock.Any(), uint32(200)).Return(&stakingTxBlockHash, nil)
+
+	unknownCovenantMember, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	// signature over different transaction
+	invalidSig, err := schnorr.Sign(validData.StakerPrivKey, make([]byte, 32))
+	require.NoError(t, err)
+
+	report, err := signerApp.ValidateUnbondingTransaction(
+		context.Background(),
+		validData.StakingInfo.StakingOutput.PkScript,
+		validData.UnbondingTx,
+		invalidSig,
+		unknownCovenantMember.PubKey(),
+		nil,
+	)
+
+	require.NoError(t, err)
+	require.False(t, report.Valid())
+	require.Equal(t, int64(1), report.Confirmations)
+
+	checks := checksByName(report)
+	for _, name := range []string{
+		signerapp.CheckCovenantMember,
+		signerapp.CheckConfirmations,
+		signerapp.CheckStakerSignature,
+	} {
+		require.Contains(t, checks, name)
+		require.False(t, checks[name].Passed)
+		require.True(t, errors.Is(checks[name].Err, signerapp.ErrInvalidSigningRequest))
+	}
+
+	// checks following failed checks are still done
+	require.True(t, checks[signerapp.CheckUnbondingOutput].Passed)
+	require.True(t, checks[signerapp.CheckStakingTxBlockInBestChain].Passed)
+}
+
+func TestValidateStopsWhenStakingTxNotFound(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData := NewValidTestData(t, deps.params)
+
+	deps.bi.EXPECT().TxByHash(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, fmt.Errorf("tx with hash %s: %w", validData.StakingTransaction.TxHash(), signerapp.ErrTxNotInChain),
+	)
+
+	report, err := signerApp.ValidateUnbondingTransaction(
+		context.Background(),
+		validData.StakingInfo.StakingOutput.PkScript,
+		validData.UnbondingTx,
+		validData.UnbondingTxStakerSig,
+		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+
+	require.NoError(t, err)
+	require.False(t, report.Valid())
+
+	last := report.Checks[len(report.Checks)-1]
+	require.Equal(t, signerapp.CheckStakingTxInChain, last.Name)
+	require.False(t, last.Passed)
+	require.True(t, errors.Is(last.Err, signerapp.ErrInvalidSigningRequest))
+	require.Nil(t, report.StakerPublicKey)
+}
+
+func TestValidateReturnsChainBackendFailure(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData := NewValidTestData(t, deps.params)
+
+	backendErr := errors.New("connection refused")
+	deps.bi.EXPECT().TxByHash(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, backendErr)
+
+	report, err := signerApp.ValidateUnbondingTransaction(
+		context.Background(),
+		validData.StakingInfo.StakingOutput.PkScript,
+		validData.UnbondingTx,
+		validData.UnbondingTxStakerSig,
+		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+
+	// outage of the chain backend is not invalid request
+	require.ErrorIs(t, err, backendErr)
+	require.False(t, errors.Is(err, signerapp.ErrInvalidSigningRequest))
+	require.Nil(t, report)
+}
+
+func TestValidateReportsStakingValueNotCoveringUnbondingFee(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData := NewValidTestData(t, deps.params)
+
+	// unbonding fee takes whole staking output value
+	params := *deps.params
+	params.UnbondingFee = btcutil.Amount(validData.StakingInfo.StakingOutput.Value)
+
+	deps.bi.EXPECT().TxByHash(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	)
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(&params, nil)
+
+	report, err := signerApp.ValidateUnbondingTransaction(
+		context.Background(),
+		validData.StakingInfo.StakingOutput.PkScript,
+		validData.UnbondingTx,
+		validData.UnbondingTxStakerSig,
+		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+
+	require.NoError(t, err)
+	require.False(t, report.Valid())
+
+	last := report.Checks[len(report.Checks)-1]
+	require.Equal(t, signerapp.CheckUnbondingOutput, last.Name)
+	require.False(t, last.Passed)
+	require.True(t, errors.Is(last.Err, signerapp.ErrInvalidSigningRequest))
+}
+
+func TestValidateStopsAfterFailedStakingAmountCheck(t *testing.T) {
+	deps := NewMockedDependencies(t)
+	signerApp := signerapp.NewSignerApp(deps.s, deps.bi, deps.pr, deps.cfg, &net)
+	validData := NewValidTestData(t, deps.params)
+
+	// staking amount is out of params bounds and lower than unbonding fee
+	params := *deps.params
+	params.MinStakingAmount = btcutil.Amount(validData.StakingInfo.StakingOutput.Value + 1)
+	params.UnbondingFee = btcutil.Amount(validData.StakingInfo.StakingOutput.Value + 1)
+
+	deps.bi.EXPECT().TxByHash(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&signerapp.TxInfo{
+			Tx:                   validData.StakingTransaction,
+			TxInclusionHeight:    200,
+			TxInclusionBlockHash: &stakingTxBlockHash,
+		}, nil,
+	)
+	deps.bi.EXPECT().BestBlockHeight(gomock.Any()).Return(uint32(300), nil)
+	deps.pr.EXPECT().ParamsByHeight(gomock.Any(), uint64(200)).Return(&params, nil)
+
+	report, err := signerApp.ValidateUnbondingTransaction(
+		context.Background(),
+		validData.StakingInfo.StakingOutput.PkScript,
+		validData.UnbondingTx,
+		validData.UnbondingTxStakerSig,
+		deps.params.CovenantPublicKeys[0],
+		nil,
+	)
+
+	require.NoError(t, err)
+	require.False(t, report.Valid())
+
+	// unbonding output is not built from invalid staking amount
+	checks := checksByName(report)
+	require.False(t, checks[signerapp.CheckStakingAmount].Passed)
+	require.NotContains(t, checks, signerapp.CheckUnbondingOutput)
+}
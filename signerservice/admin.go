@@ -0,0 +1,280 @@
+package signerservice
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/babylonlabs-io/covenant-signer/config"
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
+	s "github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/handlers"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/middlewares"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/go-chi/chi/v5"
+	logger "github.com/rs/zerolog"
+)
+
+// maxAdminContentLength limits size of admin requests, which are tiny
+const maxAdminContentLength = 4 * 1024
+
+// loopbackPrefixes are the only addresses allowed to use the admin server
+var loopbackPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("127.0.0.0/8"),
+	netip.MustParsePrefix("::1/128"),
+}
+
+// Pinger checks connectivity of signer backend
+type Pinger interface {
+	Ping() error
+}
+
+// AdminServer serves runtime operations of the signer on a separate, localhost
+// only port. Requests are authenticated by the admin token.
+type AdminServer struct {
+	httpServer *http.Server
+	// config shown to operators, with secrets redacted
+	config   []byte
+	handler  *handlers.Handler
+	params   s.ReloadableParams
+	freeze   *s.Freeze
+	backends map[string]Pinger
+}
+
+func NewAdminServer(
+	cfg *config.Config,
+	parsedConfig *config.ParsedConfig,
+	signingServer *SigningServer,
+	params s.ReloadableParams,
+	freeze *s.Freeze,
+	backends map[string]Pinger,
+) *AdminServer {
+	r := chi.NewRouter()
+	r.Use(middlewares.TracingMiddleware)
+	r.Use(middlewares.LoggingMiddleware)
+	// the listener is bound to loopback address, this additionally rejects
+	// requests forwarded to it by other local processes
+	r.Use(middlewares.NewIPFilter(loopbackPrefixes, nil).Middleware)
+	r.Use(adminAuth(parsedConfig.AdminConfig))
+	r.Use(middlewares.ContentLengthMiddleware(maxAdminContentLength))
+
+	server := &AdminServer{
+		httpServer: &http.Server{
+			Addr:         fmt.Sprintf("%s:%d", parsedConfig.AdminConfig.Host, parsedConfig.AdminConfig.Port),
+			WriteTimeout: parsedConfig.ServerConfig.WriteTimeout,
+			ReadTimeout:  parsedConfig.ServerConfig.ReadTimeout,
+			IdleTimeout:  parsedConfig.ServerConfig.IdleTimeout,
+			Handler:      r,
+		},
+		config:   config.Render(cfg.Redacted()),
+		handler:  signingServer.handler,
+		params:   params,
+		freeze:   freeze,
+		backends: backends,
+	}
+	server.SetupRoutes(r)
+
+	return server
+}
+
+// adminAuth rejects requests which are not addressed to the configured admin
+// address, e.g. requests of browsers to other host names resolved to loopback,
+// and requests without the admin token. As the token is sent in Authorization
+// header, browsers cannot send cross-origin admin requests without CORS
+// preflight, which is never allowed.
+func adminAuth(cfg *config.ParsedAdminConfig) func(http.Handler) http.Handler {
+	port := strconv.Itoa(cfg.Port)
+	allowedHosts := map[string]bool{
+		net.JoinHostPort(cfg.Host, port):    true,
+		net.JoinHostPort("localhost", port): true,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !allowedHosts[r.Host] {
+				writeResponse(w, r, http.StatusForbidden, &ErrorResponse{
+					ErrorCode: types.Forbidden.String(),
+					Message:   "invalid host",
+				})
+				return
+			}
+
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			tokenHash := sha256.Sum256([]byte(token))
+			if !ok || subtle.ConstantTimeCompare(tokenHash[:], cfg.TokenHash[:]) != 1 {
+				writeResponse(w, r, http.StatusUnauthorized, &ErrorResponse{
+					ErrorCode: types.Unauthorized.String(),
+					Message:   "invalid admin token",
+				})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (a *AdminServer) SetupRoutes(r *chi.Mux) {
+	r.Get("/v1/config", a.serveConfig)
+	r.Get("/v1/params", registerHandler(a.paramsVersions))
+	r.Post("/v1/params/reload", registerHandler(a.reloadParams))
+	r.Get("/v1/status", registerHandler(a.status))
+	r.Post("/v1/pause", registerHandler(a.setPaused(true)))
+	r.Post("/v1/resume", registerHandler(a.setPaused(false)))
+	r.Post("/v1/freeze", registerHandler(a.setFrozen(true)))
+	r.Post("/v1/unfreeze", registerHandler(a.setFrozen(false)))
+	r.Get("/v1/log-level", registerHandler(a.logLevels))
+	r.Put("/v1/log-level", registerHandler(handlers.JSONHandler(a.setLogLevel)))
+}
+
+// serveConfig returns config the signer was started with in the format of the
+// config file
+func (a *AdminServer) serveConfig(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/toml")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(a.config); err != nil {
+		logger.Ctx(r.Context()).Err(err).Msg("failed to write response")
+	}
+}
+
+func (a *AdminServer) paramsVersionsResponse() *types.AdminParamsResponse {
+	versions := a.params.ParamsVersions()
+	res := &types.AdminParamsResponse{Versions: make([]types.AdminParamsVersion, len(versions))}
+	for i, v := range versions {
+		res.Versions[i] = types.AdminParamsVersion{Version: v.Version, ActivationHeight: v.ActivationHeight}
+	}
+	return res
+}
+
+func (a *AdminServer) paramsVersions(_ *http.Request) (*handlers.Result, *types.Error) {
+	return handlers.NewResult(a.paramsVersionsResponse()), nil
+}
+
+// reloadParams loads params from their source and returns loaded versions
+func (a *AdminServer) reloadParams(r *http.Request) (*handlers.Result, *types.Error) {
+	if err := a.params.ReloadParams(r.Context()); err != nil {
+		// the reason is returned as it is, operators need it to fix the params
+		return nil, types.NewError(http.StatusUnprocessableEntity, types.ValidationError, err)
+	}
+
+	logging.Logger(logging.ComponentParams).Info().Msg("global params reloaded by admin")
+	return handlers.NewResult(a.paramsVersionsResponse()), nil
+}
+
+func (a *AdminServer) status(_ *http.Request) (*handlers.Result, *types.Error) {
+	names := make([]string, 0, len(a.backends))
+	for name := range a.backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res := &types.AdminStatusResponse{
+		Paused:   a.handler.Paused(),
+		Frozen:   a.freeze.Frozen(),
+		Backends: make([]types.AdminBackendStatus, len(names)),
+	}
+	for i, name := range names {
+		start := time.Now()
+		err := a.backends[name].Ping()
+
+		res.Backends[i] = types.AdminBackendStatus{
+			Name:      name,
+			Up:        err == nil,
+			LatencyMs: time.Since(start).Milliseconds(),
+		}
+		if err != nil {
+			res.Backends[i].Error = err.Error()
+		}
+	}
+
+	return handlers.NewResult(res), nil
+}
+
+func (a *AdminServer) setPaused(paused bool) func(*http.Request) (*handlers.Result, *types.Error) {
+	return func(_ *http.Request) (*handlers.Result, *types.Error) {
+		a.handler.SetPaused(paused)
+		logging.Logger(logging.ComponentServer).Warn().Bool("paused", paused).Msg("signing pause changed by admin")
+
+		return handlers.NewResult(&types.AdminPauseResponse{Paused: paused}), nil
+	}
+}
+
+// setFrozen freezes or unfreezes signing. Unlike pause, freeze is persisted
+// in the sentinel file and stays active after restart.
+func (a *AdminServer) setFrozen(frozen bool) func(*http.Request) (*handlers.Result, *types.Error) {
+	return func(_ *http.Request) (*handlers.Result, *types.Error) {
+		if a.freeze == nil {
+			return nil, types.NewErrorWithMsg(http.StatusNotFound, types.NotFound, "signing freeze is not configured")
+		}
+
+		var err error
+		if frozen {
+			err = a.freeze.Freeze("admin api")
+		} else {
+			err = a.freeze.Unfreeze("admin api")
+		}
+
+		if err != nil {
+			return nil, types.NewError(http.StatusInternalServerError, types.InternalServiceError, err)
+		}
+
+		return handlers.NewResult(&types.AdminFreezeResponse{Frozen: a.freeze.Frozen()}), nil
+	}
+}
+
+func logLevelsResponse() *types.AdminLogLevelResponse {
+	defaultLevel, componentLevels := logging.Levels()
+
+	res := &types.AdminLogLevelResponse{
+		Level:      defaultLevel.String(),
+		Components: make(map[string]string, len(componentLevels)),
+	}
+	for component, level := range componentLevels {
+		res.Components[component] = level.String()
+	}
+	return res
+}
+
+func (a *AdminServer) logLevels(_ *http.Request) (*handlers.Result, *types.Error) {
+	return handlers.NewResult(logLevelsResponse()), nil
+}
+
+func (a *AdminServer) setLogLevel(_ context.Context, payload *types.AdminLogLevelRequest) (*types.AdminLogLevelResponse, *types.Error) {
+	level, err := config.ParseLogLevel(payload.Level)
+	if err != nil {
+		return nil, types.NewError(http.StatusBadRequest, types.BadRequest, err)
+	}
+
+	if err := logging.SetLevel(payload.Component, level); err != nil {
+		return nil, types.NewError(http.StatusBadRequest, types.BadRequest, err)
+	}
+
+	logging.Logger(logging.ComponentServer).Info().
+		Str("log_component", payload.Component).
+		Str("level", payload.Level).
+		Msg("log level changed by admin")
+
+	return logLevelsResponse(), nil
+}
+
+// Handler returns http handler serving all routes of the admin server
+func (a *AdminServer) Handler() http.Handler {
+	return a.httpServer.Handler
+}
+
+func (a *AdminServer) Start() error {
+	logging.Logger(logging.ComponentServer).Info().Msgf("Starting admin server on %s", a.httpServer.Addr)
+	return a.httpServer.ListenAndServe()
+}
+
+func (a *AdminServer) Stop(ctx context.Context) error {
+	return a.httpServer.Shutdown(ctx)
+}
@@ -0,0 +1,233 @@
+package signerservice_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/babylonlabs-io/covenant-signer/config"
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/babylonlabs-io/covenant-signer/signerservice"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/handlers"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeParams struct {
+	signerapp.BabylonParamsRetriever
+	versions  []signerapp.ParamsVersion
+	reloadErr error
+}
+
+func (p *fakeParams) ParamsVersions() []signerapp.ParamsVersion {
+	return p.versions
+}
+
+func (p *fakeParams) ReloadParams(_ context.Context) error {
+	if p.reloadErr != nil {
+		return p.reloadErr
+	}
+	p.versions = append(p.versions, signerapp.ParamsVersion{Version: uint64(len(p.versions)), ActivationHeight: 200})
+	return nil
+}
+
+type fakePinger struct {
+	err error
+}
+
+func (p fakePinger) Ping() error {
+	return p.err
+}
+
+const (
+	testAdminToken = "admin-token"
+	testAdminHost  = "127.0.0.1:9793"
+)
+
+func newTestAdminServer(t *testing.T, params *fakeParams) (*signerservice.AdminServer, *signerservice.SigningServer) {
+	cfg := config.DefaultConfig()
+	cfg.BtcNodeConfig.Pass = "node-secret"
+	cfg.Auth.HmacKeys = []string{"emulator:0101"}
+
+	parsed := newTestConfig()
+	parsed.AdminConfig = &config.ParsedAdminConfig{
+		Host:      "127.0.0.1",
+		Port:      9793,
+		TokenHash: sha256.Sum256([]byte(testAdminToken)),
+	}
+
+	freeze, err := signerapp.NewFreeze(filepath.Join(t.TempDir(), "signing.frozen"), m.NewCovenantSignerMetrics())
+	require.NoError(t, err)
+
+	signingServer := newTestServer(t)
+	adminServer := signerservice.NewAdminServer(cfg, parsed, signingServer, params, freeze, map[string]signerservice.Pinger{
+		"full_node": fakePinger{},
+		"signer":    fakePinger{err: errors.New("connection refused")},
+	})
+
+	return adminServer, signingServer
+}
+
+func serveAdmin(server *signerservice.AdminServer, method, path string) *httptest.ResponseRecorder {
+	return serveAdminRequest(server, method, path, func(*http.Request) {})
+}
+
+func serveAdminRequest(
+	server *signerservice.AdminServer,
+	method, path string,
+	modify func(*http.Request),
+) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.RemoteAddr = "127.0.0.1:40000"
+	req.Host = testAdminHost
+	req.Header.Set("Authorization", "Bearer "+testAdminToken)
+	modify(req)
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeData[T any](t *testing.T, rec *httptest.ResponseRecorder) T {
+	var resp handlers.PublicResponse[T]
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	return resp.Data
+}
+
+func TestAdminPauseRejectsSigning(t *testing.T) {
+	adminServer, signingServer := newTestAdminServer(t, &fakeParams{})
+
+	signUnbonding := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v1/sign-unbonding-tx", strings.NewReader(`{"staking_output_pk_script_hex":"not hex"}`))
+		rec := httptest.NewRecorder()
+		signingServer.Handler().ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serveAdmin(adminServer, http.MethodPost, "/v1/pause")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.True(t, decodeData[types.AdminPauseResponse](t, rec).Paused)
+
+	rec = signUnbonding()
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	var errResp signerservice.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&errResp))
+	require.Equal(t, types.ServicePaused.String(), errResp.ErrorCode)
+	require.True(t, types.ServicePaused.Retryable())
+
+	rec = serveAdmin(adminServer, http.MethodGet, "/v1/status")
+	require.Equal(t, http.StatusOK, rec.Code)
+	status := decodeData[types.AdminStatusResponse](t, rec)
+	require.True(t, status.Paused)
+	require.Len(t, status.Backends, 2)
+	require.Equal(t, "full_node", status.Backends[0].Name)
+	require.True(t, status.Backends[0].Up)
+	require.Equal(t, "signer", status.Backends[1].Name)
+	require.False(t, status.Backends[1].Up)
+	require.Equal(t, "connection refused", status.Backends[1].Error)
+
+	rec = serveAdmin(adminServer, http.MethodPost, "/v1/resume")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.False(t, decodeData[types.AdminPauseResponse](t, rec).Paused)
+
+	// request is handled again and fails on its invalid payload
+	require.Equal(t, http.StatusBadRequest, signUnbonding().Code)
+}
+
+func TestAdminFreeze(t *testing.T) {
+	adminServer, _ := newTestAdminServer(t, &fakeParams{})
+
+	rec := serveAdmin(adminServer, http.MethodPost, "/v1/freeze")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.True(t, decodeData[types.AdminFreezeResponse](t, rec).Frozen)
+
+	rec = serveAdmin(adminServer, http.MethodGet, "/v1/status")
+	require.True(t, decodeData[types.AdminStatusResponse](t, rec).Frozen)
+
+	rec = serveAdmin(adminServer, http.MethodPost, "/v1/unfreeze")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.False(t, decodeData[types.AdminFreezeResponse](t, rec).Frozen)
+}
+
+func TestAdminConfigIsRedacted(t *testing.T) {
+	adminServer, _ := newTestAdminServer(t, &fakeParams{})
+
+	rec := serveAdmin(adminServer, http.MethodGet, "/v1/config")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	require.NotContains(t, body, "node-secret")
+	require.NotContains(t, body, "0101")
+	require.Contains(t, body, `"emulator:[REDACTED]"`)
+	require.Contains(t, body, "[admin]")
+}
+
+func TestAdminParamsReload(t *testing.T) {
+	params := &fakeParams{versions: []signerapp.ParamsVersion{{Version: 0, ActivationHeight: 100}}}
+	adminServer, _ := newTestAdminServer(t, params)
+
+	rec := serveAdmin(adminServer, http.MethodGet, "/v1/params")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, decodeData[types.AdminParamsResponse](t, rec).Versions, 1)
+
+	rec = serveAdmin(adminServer, http.MethodPost, "/v1/params/reload")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, []types.AdminParamsVersion{
+		{Version: 0, ActivationHeight: 100},
+		{Version: 1, ActivationHeight: 200},
+	}, decodeData[types.AdminParamsResponse](t, rec).Versions)
+
+	params.reloadErr = errors.New("new global params modify already loaded version 0")
+	rec = serveAdmin(adminServer, http.MethodPost, "/v1/params/reload")
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	require.Contains(t, rec.Body.String(), "modify already loaded version 0")
+}
+
+func TestAdminRejectsNonLoopbackClients(t *testing.T) {
+	adminServer, _ := newTestAdminServer(t, &fakeParams{})
+
+	rec := serveAdminRequest(adminServer, http.MethodPost, "/v1/pause", func(req *http.Request) {
+		req.RemoteAddr = "10.0.0.1:40000"
+	})
+	require.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestAdminRequiresTokenAndHost(t *testing.T) {
+	adminServer, _ := newTestAdminServer(t, &fakeParams{})
+	paused := func() bool {
+		rec := serveAdmin(adminServer, http.MethodGet, "/v1/status")
+		require.Equal(t, http.StatusOK, rec.Code)
+		return decodeData[types.AdminStatusResponse](t, rec).Paused
+	}
+
+	// request of local process or browser without the token
+	rec := serveAdminRequest(adminServer, http.MethodPost, "/v1/unfreeze", func(req *http.Request) {
+		req.Header.Del("Authorization")
+	})
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = serveAdminRequest(adminServer, http.MethodPost, "/v1/pause", func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer other-token")
+	})
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.False(t, paused())
+
+	// request to other host name resolved to loopback address
+	rec = serveAdminRequest(adminServer, http.MethodPost, "/v1/pause", func(req *http.Request) {
+		req.Host = "attacker.example:9793"
+	})
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.False(t, paused())
+
+	rec = serveAdminRequest(adminServer, http.MethodPost, "/v1/pause", func(req *http.Request) {
+		req.Host = "localhost:9793"
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.True(t, paused())
+}
@@ -5,60 +5,502 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
+	asig "github.com/babylonlabs-io/babylon/crypto/schnorr-adaptor-signature"
+	"github.com/babylonlabs-io/covenant-signer/observability/tracing"
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
 	"github.com/babylonlabs-io/covenant-signer/signerservice/handlers"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/middlewares"
 	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
 
 	"github.com/babylonlabs-io/covenant-signer/utils"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/wire"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 const (
 	// 1MB should be enough for the response
 	maxResponseSize = 1 << 20 // 1MB
+
+	// number of idle connections kept open to the signer, enough for
+	// concurrent requests sent by single client
+	maxIdleConnsPerHost = 16
 )
 
-func RequestCovenantSignaure(
+// SignerError is error response returned by the signer
+type SignerError struct {
+	StatusCode int
+	ErrorCode  types.ErrorCode
+	Message    string
+	// RetryAfter is time after which the request may be retried, as requested
+	// by the signer with Retry-After header. Zero if not requested.
+	RetryAfter time.Duration
+}
+
+func (e *SignerError) Error() string {
+	return fmt.Sprintf("signing request failed. status code: %d, error code: %s, message: %s", e.StatusCode, e.ErrorCode, e.Message)
+}
+
+// Retryable returns true if the request may succeed if sent again later
+func (e *SignerError) Retryable() bool {
+	return e.ErrorCode.Retryable()
+}
+
+type ClientConfig struct {
+	// Timeout of single attempt to send the request
+	Timeout time.Duration
+	// MaxRetries is number of times the request is re-sent after failure with
+	// retryable error or network error
+	MaxRetries uint
+	// RetryDelay is time to wait before re-sending the request for the first
+	// time. The delay is doubled after each retry up to MaxRetryDelay. Actual
+	// delay is randomized between half and full value to avoid many clients
+	// retrying at the same time.
+	RetryDelay time.Duration
+	// MaxRetryDelay is upper bound of the delay between retries
+	MaxRetryDelay time.Duration
+	// RequestSigner adds credentials to requests, nil if the signer does not
+	// authenticate requests
+	RequestSigner middlewares.RequestSigner
+}
+
+func DefaultClientConfig() *ClientConfig {
+	return &ClientConfig{
+		Timeout:       10 * time.Second,
+		MaxRetries:    3,
+		RetryDelay:    1 * time.Second,
+		MaxRetryDelay: 10 * time.Second,
+	}
+}
+
+// retryDelay returns randomized delay before retry number attempt, counting
+// from 0
+func (cfg *ClientConfig) retryDelay(attempt uint) time.Duration {
+	delay := cfg.RetryDelay
+	for i := uint(0); i < attempt && (cfg.MaxRetryDelay == 0 || delay < cfg.MaxRetryDelay); i++ {
+		delay *= 2
+	}
+
+	if cfg.MaxRetryDelay > 0 && delay > cfg.MaxRetryDelay {
+		delay = cfg.MaxRetryDelay
+	}
+
+	if delay <= 1 {
+		return delay
+	}
+
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+// Client is client of the signing server API described in OpenAPISpec.
+// Connections to the signer are reused between requests. It is safe for
+// concurrent use.
+type Client struct {
+	signerUrl  string
+	cfg        *ClientConfig
+	httpClient *http.Client
+}
+
+// sharedTransport is used by all clients, so that idle connections are reused
+// and bounded even if a client is created for every request, like by
+// RequestCovenantSignaure
+var sharedTransport = func() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	return transport
+}()
+
+func NewClient(signerUrl string, cfg *ClientConfig) *Client {
+	return &Client{
+		signerUrl: signerUrl,
+		cfg:       cfg,
+		httpClient: &http.Client{
+			Timeout:   cfg.Timeout,
+			Transport: sharedTransport,
+		},
+	}
+}
+
+// SignUnbonding requests covenant signature of unbonding transaction. Phase2Data
+// must be provided for phase-2 staking transactions and can be nil otherwise.
+func (c *Client) SignUnbonding(
 	ctx context.Context,
-	signerUrl string,
-	timeout time.Duration,
+	request *signerapp.UnbondingSigningRequest,
+) (*schnorr.Signature, error) {
+	req, err := newSignUnbondingTxRequest(request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := post[types.SignUnbondingTxResponse](ctx, c, "/v1/sign-unbonding-tx", req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SchnorSignatureFromHex(response.SignatureHex)
+}
+
+// SignUnbondingBatch requests covenant signatures of batch of unbonding
+// transactions in a single call. Results are in the same order as requests.
+// Failure of single request is reported as *types.ItemError in its result, error
+// is returned only if the whole batch failed.
+func (c *Client) SignUnbondingBatch(
+	ctx context.Context,
+	requests []*signerapp.UnbondingSigningRequest,
+) ([]*signerapp.UnbondingSigningResult, error) {
+	batch := &types.SignUnbondingTxsRequest{
+		Requests: make([]types.SignUnbondingTxRequest, 0, len(requests)),
+	}
+	for _, r := range requests {
+		req, err := newSignUnbondingTxRequest(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		batch.Requests = append(batch.Requests, *req)
+	}
+
+	response, err := post[types.SignUnbondingTxsResponse](ctx, c, "/v1/sign-unbonding-txs", batch)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response.Results) != len(requests) {
+		return nil, fmt.Errorf("signer returned %d results for %d requests", len(response.Results), len(requests))
+	}
+
+	results := make([]*signerapp.UnbondingSigningResult, 0, len(response.Results))
+	for _, r := range response.Results {
+		if r.Error != nil {
+			results = append(results, &signerapp.UnbondingSigningResult{Err: r.Error})
+			continue
+		}
+
+		sig, err := utils.SchnorSignatureFromHex(r.SignatureHex)
+
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, &signerapp.UnbondingSigningResult{Signature: sig})
+	}
+
+	return results, nil
+}
+
+// SignUnbondingByStakingTx requests covenant signature of unbonding
+// transaction of the staking output identified by its outpoint. Unbonding
+// transaction is built by the signer and returned with the signature.
+// unbondingTx is optional, if provided, the signer checks that it is equal to
+// the built transaction. phase2Data must be provided for phase-2 staking
+// transactions and can be nil otherwise.
+func (c *Client) SignUnbondingByStakingTx(
+	ctx context.Context,
+	stakingOutPoint *wire.OutPoint,
 	unbondingTx *wire.MsgTx,
 	stakerUnbondingSig *schnorr.Signature,
 	covenantMemberPublicKey *btcec.PublicKey,
+	phase2Data *signerapp.Phase2StakingData,
+) (*wire.MsgTx, *schnorr.Signature, error) {
+	req := &types.SignUnbondingByStakingTxRequest{
+		StakingTxHashHex:      stakingOutPoint.Hash.String(),
+		StakingOutputIndex:    stakingOutPoint.Index,
+		StakerUnbondingSigHex: hex.EncodeToString(stakerUnbondingSig.Serialize()),
+		CovenantPublicKey:     hex.EncodeToString(covenantMemberPublicKey.SerializeCompressed()),
+		Phase2StakingData:     newPhase2StakingDataRequest(phase2Data),
+	}
+
+	if unbondingTx != nil {
+		unbondingTxHex, err := utils.SerializeBTCTxToHex(unbondingTx)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		req.UnbondingTxHex = unbondingTxHex
+	}
+
+	response, err := post[types.SignUnbondingByStakingTxResponse](ctx, c, "/v1/sign-unbonding-by-staking-tx", req)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	builtTx, _, err := utils.NewBTCTxFromHex(response.UnbondingTxHex)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := utils.SchnorSignatureFromHex(response.SignatureHex)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return builtTx, sig, nil
+}
+
+// SignUnbondingPsbt requests covenant signature of unbonding transaction from
+// the PSBT packet. Packet must contain staking output as witness utxo and
+// staker signature. Returned packet has both staker and covenant signatures.
+// phase2Data must be provided for phase-2 staking transactions and can be nil
+// otherwise.
+func (c *Client) SignUnbondingPsbt(
+	ctx context.Context,
+	packet *psbt.Packet,
+	covenantMemberPublicKey *btcec.PublicKey,
+	phase2Data *signerapp.Phase2StakingData,
+) (*psbt.Packet, error) {
+	packetBase64, err := packet.B64Encode()
+
+	if err != nil {
+		return nil, err
+	}
+
+	req := &types.SignUnbondingPsbtRequest{
+		PsbtBase64:        packetBase64,
+		CovenantPublicKey: hex.EncodeToString(covenantMemberPublicKey.SerializeCompressed()),
+		Phase2StakingData: newPhase2StakingDataRequest(phase2Data),
+	}
+
+	response, err := post[types.SignUnbondingPsbtResponse](ctx, c, "/v1/sign-unbonding-psbt", req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return psbt.NewFromRawBytes(strings.NewReader(response.PsbtBase64), true)
+}
+
+// ValidateUnbonding validates unbonding signing request without signing it.
+// Failed checks are reported in the response.
+func (c *Client) ValidateUnbonding(
+	ctx context.Context,
+	request *signerapp.UnbondingSigningRequest,
+) (*types.ValidateUnbondingTxResponse, error) {
+	req, err := newSignUnbondingTxRequest(request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return post[types.ValidateUnbondingTxResponse](ctx, c, "/v1/validate-unbonding-tx", req)
+}
+
+// SignSlashing requests covenant adaptor signatures over slashing transaction
+// spending staking output. Returned signatures are in the same order as
+// finality providers of the staking transaction. phase2Data must be provided
+// for phase-2 staking transactions and can be nil otherwise.
+func (c *Client) SignSlashing(
+	ctx context.Context,
+	slashingTx *wire.MsgTx,
+	covenantMemberPublicKey *btcec.PublicKey,
 	stakingTransactionPkScript []byte,
-) (*schnorr.Signature, error) {
+	phase2Data *signerapp.Phase2StakingData,
+) ([]*signerapp.FinalityProviderAdaptorSignature, error) {
+	slashingTxHex, err := utils.SerializeBTCTxToHex(slashingTx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req := &types.SignSlashingTxRequest{
+		StakingOutputPkScriptHex: hex.EncodeToString(stakingTransactionPkScript),
+		SlashingTxHex:            slashingTxHex,
+		CovenantPublicKey:        hex.EncodeToString(covenantMemberPublicKey.SerializeCompressed()),
+		Phase2StakingData:        newPhase2StakingDataRequest(phase2Data),
+	}
+
+	response, err := post[types.SignSlashingTxResponse](ctx, c, "/v1/sign-slashing-tx", req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parseAdaptorSignatures(response.AdaptorSignatures)
+}
+
+// SignUnbondingSlashing requests covenant adaptor signatures over slashing
+// transaction spending unbonding output of the unbonding transaction.
+// phase2Data must be provided for phase-2 staking transactions and can be nil
+// otherwise.
+func (c *Client) SignUnbondingSlashing(
+	ctx context.Context,
+	unbondingTx *wire.MsgTx,
+	slashingTx *wire.MsgTx,
+	covenantMemberPublicKey *btcec.PublicKey,
+	stakingTransactionPkScript []byte,
+	phase2Data *signerapp.Phase2StakingData,
+) ([]*signerapp.FinalityProviderAdaptorSignature, error) {
 	unbondingTxHex, err := utils.SerializeBTCTxToHex(unbondingTx)
 
 	if err != nil {
 		return nil, err
 	}
 
-	keyHex := hex.EncodeToString(covenantMemberPublicKey.SerializeCompressed())
+	slashingTxHex, err := utils.SerializeBTCTxToHex(slashingTx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req := &types.SignUnbondingSlashingTxRequest{
+		StakingOutputPkScriptHex: hex.EncodeToString(stakingTransactionPkScript),
+		UnbondingTxHex:           unbondingTxHex,
+		SlashingTxHex:            slashingTxHex,
+		CovenantPublicKey:        hex.EncodeToString(covenantMemberPublicKey.SerializeCompressed()),
+		Phase2StakingData:        newPhase2StakingDataRequest(phase2Data),
+	}
+
+	response, err := post[types.SignUnbondingSlashingTxResponse](ctx, c, "/v1/sign-unbonding-slashing-tx", req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parseAdaptorSignatures(response.AdaptorSignatures)
+}
 
-	pkScriptHex := hex.EncodeToString(stakingTransactionPkScript)
+func newSignUnbondingTxRequest(request *signerapp.UnbondingSigningRequest) (*types.SignUnbondingTxRequest, error) {
+	unbondingTxHex, err := utils.SerializeBTCTxToHex(request.UnbondingTx)
 
-	sigHex := hex.EncodeToString(stakerUnbondingSig.Serialize())
+	if err != nil {
+		return nil, err
+	}
 
-	req := types.SignUnbondingTxRequest{
-		StakingOutputPkScriptHex: pkScriptHex,
+	return &types.SignUnbondingTxRequest{
+		StakingOutputPkScriptHex: hex.EncodeToString(request.StakingOutputPkScript),
 		UnbondingTxHex:           unbondingTxHex,
-		StakerUnbondingSigHex:    sigHex,
-		CovenantPublicKey:        keyHex,
+		StakerUnbondingSigHex:    hex.EncodeToString(request.StakerUnbondingSig.Serialize()),
+		CovenantPublicKey:        hex.EncodeToString(request.CovenantPublicKey.SerializeCompressed()),
+		Phase2StakingData:        newPhase2StakingDataRequest(request.Phase2Data),
+	}, nil
+}
+
+func newPhase2StakingDataRequest(phase2Data *signerapp.Phase2StakingData) types.Phase2StakingData {
+	if phase2Data == nil {
+		return types.Phase2StakingData{}
+	}
+
+	req := types.Phase2StakingData{
+		StakerPublicKeyHex: hex.EncodeToString(schnorr.SerializePubKey(phase2Data.StakerPublicKey)),
+		StakingTime:        phase2Data.StakingTime,
+	}
+	for _, fpKey := range phase2Data.FinalityProviderPublicKeys {
+		req.FinalityProviderPublicKeysHex = append(
+			req.FinalityProviderPublicKeysHex,
+			hex.EncodeToString(schnorr.SerializePubKey(fpKey)),
+		)
+	}
+
+	return req
+}
+
+func parseAdaptorSignatures(
+	sigs []types.FinalityProviderAdaptorSignature,
+) ([]*signerapp.FinalityProviderAdaptorSignature, error) {
+	result := make([]*signerapp.FinalityProviderAdaptorSignature, 0, len(sigs))
+	for _, sig := range sigs {
+		fpKey, err := utils.PubKeyFromHex(sig.FinalityProviderPublicKeyHex)
+
+		if err != nil {
+			return nil, err
+		}
+
+		adaptorSig, err := asig.NewAdaptorSignatureFromHex(sig.AdaptorSignatureHex)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &signerapp.FinalityProviderAdaptorSignature{
+			FinalityProviderPublicKey: fpKey,
+			Signature:                 adaptorSig,
+		})
+	}
+
+	return result, nil
+}
+
+// isRetryable returns true for retryable signer errors and transport errors.
+// Errors caused by cancelled context are not retried. Other errors, e.g.
+// invalid response of the signer, would fail again and are not retried.
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
 	}
 
+	var signerErr *SignerError
+	if errors.As(err, &signerErr) {
+		return signerErr.Retryable()
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	// connection dropped while reading the response
+	return errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// post sends request to the signer, re-sending it after retryable failures
+// with exponential backoff, or after delay requested by the signer if longer
+func post[Resp any](ctx context.Context, c *Client, path string, req any) (*Resp, error) {
 	marshalled, err := json.Marshal(req)
 
 	if err != nil {
 		return nil, err
 	}
 
-	route := fmt.Sprintf("%s/v1/sign-unbonding-tx", signerUrl)
+	for attempt := uint(0); ; attempt++ {
+		response, err := postOnce[Resp](ctx, c, path, marshalled)
+
+		if err == nil {
+			return response, nil
+		}
+
+		if attempt >= c.cfg.MaxRetries || !isRetryable(ctx, err) {
+			return nil, err
+		}
+
+		delay := c.cfg.retryDelay(attempt)
+		var signerErr *SignerError
+		if errors.As(err, &signerErr) && signerErr.RetryAfter > delay {
+			delay = signerErr.RetryAfter
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(delay):
+		}
+	}
+}
+
+func postOnce[Resp any](ctx context.Context, c *Client, path string, marshalled []byte) (*Resp, error) {
+	route := fmt.Sprintf("%s%s", c.signerUrl, path)
 
 	httpRequest, err := http.NewRequestWithContext(ctx, "POST", route, bytes.NewReader(marshalled))
 
@@ -68,10 +510,26 @@ func RequestCovenantSignaure(
 
 	// use json
 	httpRequest.Header.Set("Content-Type", "application/json")
+	// continue trace of the caller in the signer
+	tracing.Inject(ctx, propagation.HeaderCarrier(httpRequest.Header))
+
+	if c.cfg.RequestSigner != nil {
+		// credentials are created for each attempt, as signed requests cannot
+		// be replayed
+		err := c.cfg.RequestSigner.SignRequest(&middlewares.AuthRequest{
+			Method: httpRequest.Method,
+			Path:   httpRequest.URL.Path,
+			Header: httpRequest.Header,
+			Body:   marshalled,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	client := http.Client{Timeout: timeout}
 	// send the request
-	res, err := client.Do(httpRequest)
+	res, err := c.httpClient.Do(httpRequest)
 
 	if err != nil {
 		return nil, err
@@ -89,13 +547,50 @@ func RequestCovenantSignaure(
 	}
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("signing request failed. status code: %d, message: %s", res.StatusCode, string(resBody))
+		var errResponse ErrorResponse
+		if err := json.Unmarshal(resBody, &errResponse); err != nil {
+			// not all errors are returned in json format e.g too large request
+			errResponse.Message = string(resBody)
+		}
+
+		signerErr := &SignerError{
+			StatusCode: res.StatusCode,
+			ErrorCode:  types.ErrorCode(errResponse.ErrorCode),
+			Message:    errResponse.Message,
+		}
+		if seconds, err := strconv.Atoi(res.Header.Get(middlewares.RetryAfterHeader)); err == nil && seconds > 0 {
+			signerErr.RetryAfter = time.Duration(seconds) * time.Second
+		}
+
+		return nil, signerErr
 	}
 
-	var response handlers.PublicResponse[types.SignUnbondingTxResponse]
+	var response handlers.PublicResponse[Resp]
 	if err := json.Unmarshal(resBody, &response); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid response of the signer: %w", err)
 	}
 
-	return utils.SchnorSignatureFromHex(response.Data.SignatureHex)
+	return &response.Data, nil
+}
+
+// RequestCovenantSignaure requests covenant signature of unbonding transaction
+// of phase-1 staking transaction.
+//
+// Deprecated: use Client.SignUnbonding
+func RequestCovenantSignaure(
+	ctx context.Context,
+	signerUrl string,
+	timeout time.Duration,
+	unbondingTx *wire.MsgTx,
+	stakerUnbondingSig *schnorr.Signature,
+	covenantMemberPublicKey *btcec.PublicKey,
+	stakingTransactionPkScript []byte,
+) (*schnorr.Signature, error) {
+	client := NewClient(signerUrl, &ClientConfig{Timeout: timeout})
+	return client.SignUnbonding(ctx, &signerapp.UnbondingSigningRequest{
+		StakingOutputPkScript: stakingTransactionPkScript,
+		UnbondingTx:           unbondingTx,
+		StakerUnbondingSig:    stakerUnbondingSig,
+		CovenantPublicKey:     covenantMemberPublicKey,
+	})
 }
@@ -0,0 +1,249 @@
+package signerservice_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/babylonlabs-io/covenant-signer/signerservice"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/handlers"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/middlewares"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSigningRequest(t *testing.T) (*signerapp.UnbondingSigningRequest, *schnorr.Signature) {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	sig, err := schnorr.Sign(key, make([]byte, 32))
+	require.NoError(t, err)
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+
+	return &signerapp.UnbondingSigningRequest{
+		StakingOutputPkScript: []byte{0x51},
+		UnbondingTx:           tx,
+		StakerUnbondingSig:    sig,
+		CovenantPublicKey:     key.PubKey(),
+	}, sig
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	require.NoError(t, json.NewEncoder(w).Encode(body))
+}
+
+func hexSig(sig *schnorr.Signature) string {
+	return hex.EncodeToString(sig.Serialize())
+}
+
+func testClientConfig() *signerservice.ClientConfig {
+	return &signerservice.ClientConfig{
+		Timeout:       time.Second,
+		MaxRetries:    2,
+		RetryDelay:    time.Millisecond,
+		MaxRetryDelay: 4 * time.Millisecond,
+	}
+}
+
+func TestClientRetriesRetryableErrors(t *testing.T) {
+	req, sig := newTestSigningRequest(t)
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			writeJSON(t, w, http.StatusServiceUnavailable, &signerservice.ErrorResponse{
+				ErrorCode: types.ChainReorg.String(),
+				Message:   "staking tx reorged",
+			})
+			return
+		}
+
+		writeJSON(t, w, http.StatusOK, handlers.PublicResponse[types.SignUnbondingTxResponse]{
+			Data: types.SignUnbondingTxResponse{SignatureHex: hexSig(sig)},
+		})
+	}))
+	defer srv.Close()
+
+	client := signerservice.NewClient(srv.URL, testClientConfig())
+
+	received, err := client.SignUnbonding(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, sig.Serialize(), received.Serialize())
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestClientDoesNotRetryInvalidRequest(t *testing.T) {
+	req, _ := newTestSigningRequest(t)
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		writeJSON(t, w, http.StatusBadRequest, &signerservice.ErrorResponse{
+			ErrorCode: types.BadRequest.String(),
+			Message:   "invalid unbonding transaction",
+		})
+	}))
+	defer srv.Close()
+
+	client := signerservice.NewClient(srv.URL, testClientConfig())
+
+	_, err := client.SignUnbonding(context.Background(), req)
+	require.Error(t, err)
+
+	var signerErr *signerservice.SignerError
+	require.True(t, errors.As(err, &signerErr))
+	require.Equal(t, http.StatusBadRequest, signerErr.StatusCode)
+	require.Equal(t, types.BadRequest, signerErr.ErrorCode)
+	require.False(t, signerErr.Retryable())
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestClientDoesNotRetryInvalidResponse(t *testing.T) {
+	req, _ := newTestSigningRequest(t)
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":`))
+	}))
+	defer srv.Close()
+
+	client := signerservice.NewClient(srv.URL, testClientConfig())
+
+	// request was signed, sending it again would sign it again
+	_, err := client.SignUnbonding(context.Background(), req)
+	require.Error(t, err)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestClientRetriesDroppedConnection(t *testing.T) {
+	req, sig := newTestSigningRequest(t)
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			require.NoError(t, conn.Close())
+			return
+		}
+
+		writeJSON(t, w, http.StatusOK, handlers.PublicResponse[types.SignUnbondingTxResponse]{
+			Data: types.SignUnbondingTxResponse{SignatureHex: hexSig(sig)},
+		})
+	}))
+	defer srv.Close()
+
+	client := signerservice.NewClient(srv.URL, testClientConfig())
+
+	received, err := client.SignUnbonding(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, sig.Serialize(), received.Serialize())
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestClientGivesUpAfterMaxRetries(t *testing.T) {
+	req, _ := newTestSigningRequest(t)
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		writeJSON(t, w, http.StatusServiceUnavailable, &signerservice.ErrorResponse{
+			ErrorCode: types.ChainReorg.String(),
+			Message:   "staking tx reorged",
+		})
+	}))
+	defer srv.Close()
+
+	client := signerservice.NewClient(srv.URL, testClientConfig())
+
+	_, err := client.SignUnbonding(context.Background(), req)
+
+	var signerErr *signerservice.SignerError
+	require.True(t, errors.As(err, &signerErr))
+	require.True(t, signerErr.Retryable())
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestClientWaitsRetryAfter(t *testing.T) {
+	req, sig := newTestSigningRequest(t)
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set(middlewares.RetryAfterHeader, "1")
+			writeJSON(t, w, http.StatusTooManyRequests, &signerservice.ErrorResponse{
+				ErrorCode: types.TooManyRequests.String(),
+				Message:   "too many requests",
+			})
+			return
+		}
+
+		writeJSON(t, w, http.StatusOK, handlers.PublicResponse[types.SignUnbondingTxResponse]{
+			Data: types.SignUnbondingTxResponse{SignatureHex: hexSig(sig)},
+		})
+	}))
+	defer srv.Close()
+
+	client := signerservice.NewClient(srv.URL, testClientConfig())
+
+	start := time.Now()
+	_, err := client.SignUnbonding(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), calls.Load())
+	// Retry-After is longer than the backoff of the client
+	require.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestRequestCovenantSignatureReusesConnections(t *testing.T) {
+	req, sig := newTestSigningRequest(t)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, handlers.PublicResponse[types.SignUnbondingTxResponse]{
+			Data: types.SignUnbondingTxResponse{SignatureHex: hexSig(sig)},
+		})
+	}))
+	var conns atomic.Int32
+	srv.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	srv.Start()
+	defer srv.Close()
+
+	for i := 0; i < 5; i++ {
+		received, err := signerservice.RequestCovenantSignaure(
+			context.Background(),
+			srv.URL,
+			time.Second,
+			req.UnbondingTx,
+			req.StakerUnbondingSig,
+			req.CovenantPublicKey,
+			req.StakingOutputPkScript,
+		)
+		require.NoError(t, err)
+		require.Equal(t, sig.Serialize(), received.Serialize())
+	}
+
+	// every call reuses idle connection of the previous one
+	require.Equal(t, int32(1), conns.Load())
+}
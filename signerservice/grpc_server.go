@@ -0,0 +1,260 @@
+package signerservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/handlers"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/middlewares"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/signerpb"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	logger "github.com/rs/zerolog"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonlabs-io/covenant-signer/config"
+)
+
+// ErrorDomain is domain of google.rpc.ErrorInfo attached to gRPC errors
+const ErrorDomain = middlewares.GrpcErrorDomain
+
+var _ signerpb.SignerServer = (*GrpcServer)(nil)
+
+// GrpcServer exposes the same operations as SigningServer over gRPC. Requests
+// are handled by the same handlers as http requests.
+type GrpcServer struct {
+	signerpb.UnimplementedSignerServer
+
+	grpcServer *grpc.Server
+	addr       string
+	handler    *handlers.Handler
+}
+
+// NewGrpcServer returns gRPC server sharing handlers, address filter,
+// authentication and rate limits with the http server
+func NewGrpcServer(
+	cfg *config.ParsedConfig,
+	httpServer *SigningServer,
+) *GrpcServer {
+	maxContentLength := int64(cfg.ServerConfig.MaxContentLength)
+	// batch request contains up to max batch size single requests
+	maxBatchContentLength := maxContentLength * int64(cfg.SignerAppConfig.MaxBatchSize)
+
+	interceptors := []grpc.UnaryServerInterceptor{
+		middlewares.TracingInterceptor,
+		middlewares.LoggingInterceptor,
+	}
+	if httpServer.ipFilter != nil {
+		interceptors = append(interceptors, httpServer.ipFilter.Interceptor)
+	}
+	interceptors = append(interceptors, middlewares.MessageSizeInterceptor(maxContentLength, map[string]int64{
+		signerpb.Signer_SignUnbondingTxs_FullMethodName: maxBatchContentLength,
+	}))
+	if len(httpServer.authenticators) > 0 {
+		interceptors = append(interceptors, middlewares.AuthInterceptor(httpServer.authenticators, httpServer.metrics))
+	}
+	if httpServer.rateLimiter != nil {
+		interceptors = append(interceptors, httpServer.rateLimiter.Interceptor)
+	}
+
+	grpcServer := grpc.NewServer(
+		// limit of the transport, limits of single methods are checked by
+		// interceptor
+		grpc.MaxRecvMsgSize(int(maxBatchContentLength)),
+		grpc.ChainUnaryInterceptor(interceptors...),
+	)
+
+	server := &GrpcServer{
+		grpcServer: grpcServer,
+		addr:       fmt.Sprintf("%s:%d", cfg.ServerConfig.Host, cfg.ServerConfig.GrpcPort),
+		handler:    httpServer.handler,
+	}
+	signerpb.RegisterSignerServer(grpcServer, server)
+
+	return server
+}
+
+// Serve serves gRPC requests received by the listener
+func (s *GrpcServer) Serve(lis net.Listener) error {
+	return s.grpcServer.Serve(lis)
+}
+
+func (s *GrpcServer) Start() error {
+	logging.Logger(logging.ComponentServer).Info().Msgf("Starting gRPC server on %s", s.addr)
+
+	lis, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(lis)
+}
+
+func (s *GrpcServer) Stop(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+		return ctx.Err()
+	}
+}
+
+func (s *GrpcServer) SignUnbondingTx(
+	ctx context.Context,
+	req *signerpb.SignUnbondingTxRequest,
+) (*signerpb.SignUnbondingTxResponse, error) {
+	return handleGrpc(ctx, req, signUnbondingTxRequestFromPb, s.handler.SignUnbonding,
+		func(resp *types.SignUnbondingTxResponse) *signerpb.SignUnbondingTxResponse {
+			return &signerpb.SignUnbondingTxResponse{SignatureHex: resp.SignatureHex}
+		},
+	)
+}
+
+func (s *GrpcServer) SignUnbondingTxs(
+	ctx context.Context,
+	req *signerpb.SignUnbondingTxsRequest,
+) (*signerpb.SignUnbondingTxsResponse, error) {
+	return handleGrpc(ctx, req, signUnbondingTxsRequestFromPb, s.handler.SignUnbondingBatch, signUnbondingTxsResponseToPb)
+}
+
+func (s *GrpcServer) SignUnbondingByStakingTx(
+	ctx context.Context,
+	req *signerpb.SignUnbondingByStakingTxRequest,
+) (*signerpb.SignUnbondingByStakingTxResponse, error) {
+	return handleGrpc(ctx, req, signUnbondingByStakingTxRequestFromPb, s.handler.SignUnbondingByStakingTx,
+		func(resp *types.SignUnbondingByStakingTxResponse) *signerpb.SignUnbondingByStakingTxResponse {
+			return &signerpb.SignUnbondingByStakingTxResponse{
+				UnbondingTxHex: resp.UnbondingTxHex,
+				SignatureHex:   resp.SignatureHex,
+			}
+		},
+	)
+}
+
+func (s *GrpcServer) SignUnbondingPsbt(
+	ctx context.Context,
+	req *signerpb.SignUnbondingPsbtRequest,
+) (*signerpb.SignUnbondingPsbtResponse, error) {
+	return handleGrpc(ctx, req, signUnbondingPsbtRequestFromPb, s.handler.SignUnbondingPsbt,
+		func(resp *types.SignUnbondingPsbtResponse) *signerpb.SignUnbondingPsbtResponse {
+			return &signerpb.SignUnbondingPsbtResponse{PsbtBase64: resp.PsbtBase64}
+		},
+	)
+}
+
+func (s *GrpcServer) SignSlashingTx(
+	ctx context.Context,
+	req *signerpb.SignSlashingTxRequest,
+) (*signerpb.SignSlashingTxResponse, error) {
+	return handleGrpc(ctx, req, signSlashingTxRequestFromPb, s.handler.SignSlashing,
+		func(resp *types.SignSlashingTxResponse) *signerpb.SignSlashingTxResponse {
+			return &signerpb.SignSlashingTxResponse{AdaptorSignatures: adaptorSignaturesToPb(resp.AdaptorSignatures)}
+		},
+	)
+}
+
+func (s *GrpcServer) SignUnbondingSlashingTx(
+	ctx context.Context,
+	req *signerpb.SignUnbondingSlashingTxRequest,
+) (*signerpb.SignUnbondingSlashingTxResponse, error) {
+	return handleGrpc(ctx, req, signUnbondingSlashingTxRequestFromPb, s.handler.SignUnbondingSlashing,
+		func(resp *types.SignUnbondingSlashingTxResponse) *signerpb.SignUnbondingSlashingTxResponse {
+			return &signerpb.SignUnbondingSlashingTxResponse{AdaptorSignatures: adaptorSignaturesToPb(resp.AdaptorSignatures)}
+		},
+	)
+}
+
+func (s *GrpcServer) ValidateUnbondingTx(
+	ctx context.Context,
+	req *signerpb.SignUnbondingTxRequest,
+) (*signerpb.ValidateUnbondingTxResponse, error) {
+	return handleGrpc(ctx, req, signUnbondingTxRequestFromPb, s.handler.ValidateUnbonding, validateUnbondingTxResponseToPb)
+}
+
+// handleGrpc converts gRPC request to request type shared with http server,
+// handles it and converts the response back
+func handleGrpc[PbReq, Req, Resp, PbResp any](
+	ctx context.Context,
+	pbReq *PbReq,
+	fromPb func(*PbReq) (*Req, *types.Error),
+	handlerFunc handlers.HandlerFunc[Req, Resp],
+	toPb func(*Resp) *PbResp,
+) (*PbResp, error) {
+	req, err := fromPb(pbReq)
+
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	resp, err := handlerFunc(ctx, req)
+
+	if err != nil {
+		return nil, grpcError(ctx, err)
+	}
+
+	return toPb(resp), nil
+}
+
+func grpcCode(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusRequestEntityTooLarge, http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+// grpcError converts handler error to gRPC status error. Error code is
+// attached as reason of google.rpc.ErrorInfo detail.
+func grpcError(ctx context.Context, err *types.Error) error {
+	message := err.Err.Error()
+	if err.StatusCode >= http.StatusInternalServerError {
+		logger.Ctx(ctx).Error().Err(err).Msg("request failed with 5xx error")
+		// Hide the internal message error from client, the same as for http
+		if err.ErrorCode == types.InternalServiceError {
+			message = "Internal service error"
+		}
+	}
+
+	return middlewares.NewGrpcError(grpcCode(err.StatusCode), err.ErrorCode, message)
+}
+
+// GrpcErrorCode returns error code of the error returned by gRPC server, false
+// if the error does not carry error code e.g network errors
+func GrpcErrorCode(err error) (types.ErrorCode, bool) {
+	var grpcStatus interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &grpcStatus) {
+		return "", false
+	}
+
+	for _, d := range grpcStatus.GRPCStatus().Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Domain == ErrorDomain {
+			return types.ErrorCode(info.Reason), true
+		}
+	}
+
+	return "", false
+}
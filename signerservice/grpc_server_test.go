@@ -0,0 +1,133 @@
+package signerservice_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/babylonlabs-io/covenant-signer/config"
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/signerservice"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/middlewares"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/signerpb"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestGrpcClient(t *testing.T, opts ...grpc.DialOption) signerpb.SignerClient {
+	return newTestGrpcClientWithConfig(t, newTestConfig(), opts...)
+}
+
+func newTestGrpcClientWithConfig(t *testing.T, cfg *config.ParsedConfig, opts ...grpc.DialOption) signerpb.SignerClient {
+	httpServer, err := signerservice.New(context.Background(), cfg, nil, m.NewCovenantSignerMetrics())
+	require.NoError(t, err)
+	server := signerservice.NewGrpcServer(cfg, httpServer)
+
+	lis := bufconn.Listen(1 << 20)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(func() {
+		require.NoError(t, server.Stop(context.Background()))
+	})
+
+	opts = append(opts,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	conn, err := grpc.NewClient("passthrough:///bufnet", opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+	})
+
+	return signerpb.NewSignerClient(conn)
+}
+
+func TestGrpcInvalidRequestReturnsErrorCode(t *testing.T) {
+	client := newTestGrpcClient(t)
+
+	_, err := client.SignUnbondingTx(context.Background(), &signerpb.SignUnbondingTxRequest{
+		StakingOutputPkScriptHex: "not hex",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, status.Convert(err).Message(), "invalid staking output pk script")
+
+	code, ok := signerservice.GrpcErrorCode(err)
+	require.True(t, ok)
+	require.Equal(t, types.BadRequest, code)
+}
+
+func TestGrpcBatchReportsItemErrors(t *testing.T) {
+	client := newTestGrpcClient(t)
+
+	resp, err := client.SignUnbondingTxs(context.Background(), &signerpb.SignUnbondingTxsRequest{
+		Requests: []*signerpb.SignUnbondingTxRequest{
+			{StakingOutputPkScriptHex: "not hex"},
+			{StakingOutputPkScriptHex: "00", CovenantPublicKey: "not hex"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 2)
+	for _, r := range resp.Results {
+		require.Empty(t, r.SignatureHex)
+		require.Equal(t, types.BadRequest.String(), r.Error.ErrorCode)
+	}
+}
+
+func TestGrpcRejectsTooLargeRequests(t *testing.T) {
+	client := newTestGrpcClient(t)
+
+	// larger than max content length of single request, but smaller than
+	// batch limit
+	_, err := client.SignUnbondingTx(context.Background(), &signerpb.SignUnbondingTxRequest{
+		UnbondingTxHex: strings.Repeat("00", 8192),
+	})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = client.SignUnbondingTxs(context.Background(), &signerpb.SignUnbondingTxsRequest{
+		Requests: []*signerpb.SignUnbondingTxRequest{
+			{StakingOutputPkScriptHex: "not hex", UnbondingTxHex: strings.Repeat("00", 8192)},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestGrpcAuthentication(t *testing.T) {
+	secret := bytes.Repeat([]byte{1}, 32)
+	authConfig := &config.AuthConfig{
+		HmacKeys:     []string{"emulator:" + hex.EncodeToString(secret)},
+		MaxClockSkew: 30,
+	}
+	parsedAuthConfig, err := authConfig.Parse()
+	require.NoError(t, err)
+
+	cfg := newTestConfig()
+	cfg.AuthConfig = parsedAuthConfig
+
+	req := &signerpb.SignUnbondingTxRequest{StakingOutputPkScriptHex: "not hex"}
+
+	unauthenticated := newTestGrpcClientWithConfig(t, cfg)
+	_, err = unauthenticated.SignUnbondingTx(context.Background(), req)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	code, ok := signerservice.GrpcErrorCode(err)
+	require.True(t, ok)
+	require.Equal(t, types.Unauthorized, code)
+
+	// authenticated request reaches the handler
+	authenticated := newTestGrpcClientWithConfig(t, cfg, grpc.WithUnaryInterceptor(
+		middlewares.AuthClientInterceptor(middlewares.NewHmacRequestSigner("emulator", secret)),
+	))
+	_, err = authenticated.SignUnbondingTx(context.Background(), req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
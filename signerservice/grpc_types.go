@@ -0,0 +1,180 @@
+package signerservice
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/babylonlabs-io/covenant-signer/signerservice/signerpb"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+)
+
+// Conversions between gRPC messages and request/response types shared with
+// HTTP server
+
+func phase2StakingDataFromPb(data *signerpb.Phase2StakingData) (types.Phase2StakingData, *types.Error) {
+	if data == nil {
+		return types.Phase2StakingData{}, nil
+	}
+
+	if data.StakingTime > math.MaxUint16 {
+		return types.Phase2StakingData{}, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid staking time")
+	}
+
+	return types.Phase2StakingData{
+		StakerPublicKeyHex:            data.StakerPublicKeyHex,
+		FinalityProviderPublicKeysHex: data.FinalityProviderPublicKeysHex,
+		StakingTime:                   uint16(data.StakingTime),
+	}, nil
+}
+
+func signUnbondingTxRequestFromPb(req *signerpb.SignUnbondingTxRequest) (*types.SignUnbondingTxRequest, *types.Error) {
+	phase2Data, err := phase2StakingDataFromPb(req.Phase2StakingData)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SignUnbondingTxRequest{
+		StakingOutputPkScriptHex: req.StakingOutputPkScriptHex,
+		UnbondingTxHex:           req.UnbondingTxHex,
+		StakerUnbondingSigHex:    req.StakerUnbondingSigHex,
+		CovenantPublicKey:        req.CovenantPublicKey,
+		Phase2StakingData:        phase2Data,
+	}, nil
+}
+
+func signUnbondingTxsRequestFromPb(req *signerpb.SignUnbondingTxsRequest) (*types.SignUnbondingTxsRequest, *types.Error) {
+	batch := &types.SignUnbondingTxsRequest{
+		Requests: make([]types.SignUnbondingTxRequest, 0, len(req.Requests)),
+	}
+	for _, r := range req.Requests {
+		converted, err := signUnbondingTxRequestFromPb(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		batch.Requests = append(batch.Requests, *converted)
+	}
+
+	return batch, nil
+}
+
+func signUnbondingTxsResponseToPb(resp *types.SignUnbondingTxsResponse) *signerpb.SignUnbondingTxsResponse {
+	results := make([]*signerpb.SignUnbondingTxResult, 0, len(resp.Results))
+	for _, r := range resp.Results {
+		result := &signerpb.SignUnbondingTxResult{
+			SignatureHex: r.SignatureHex,
+		}
+		if r.Error != nil {
+			result.Error = &signerpb.ItemError{
+				ErrorCode: r.Error.ErrorCode,
+				Message:   r.Error.Message,
+			}
+		}
+		results = append(results, result)
+	}
+
+	return &signerpb.SignUnbondingTxsResponse{Results: results}
+}
+
+func signUnbondingByStakingTxRequestFromPb(
+	req *signerpb.SignUnbondingByStakingTxRequest,
+) (*types.SignUnbondingByStakingTxRequest, *types.Error) {
+	phase2Data, err := phase2StakingDataFromPb(req.Phase2StakingData)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SignUnbondingByStakingTxRequest{
+		StakingTxHashHex:      req.StakingTxHashHex,
+		StakingOutputIndex:    req.StakingOutputIndex,
+		StakerUnbondingSigHex: req.StakerUnbondingSigHex,
+		CovenantPublicKey:     req.CovenantPublicKey,
+		UnbondingTxHex:        req.UnbondingTxHex,
+		Phase2StakingData:     phase2Data,
+	}, nil
+}
+
+func signUnbondingPsbtRequestFromPb(req *signerpb.SignUnbondingPsbtRequest) (*types.SignUnbondingPsbtRequest, *types.Error) {
+	phase2Data, err := phase2StakingDataFromPb(req.Phase2StakingData)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SignUnbondingPsbtRequest{
+		PsbtBase64:        req.PsbtBase64,
+		CovenantPublicKey: req.CovenantPublicKey,
+		Phase2StakingData: phase2Data,
+	}, nil
+}
+
+func signSlashingTxRequestFromPb(req *signerpb.SignSlashingTxRequest) (*types.SignSlashingTxRequest, *types.Error) {
+	phase2Data, err := phase2StakingDataFromPb(req.Phase2StakingData)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SignSlashingTxRequest{
+		StakingOutputPkScriptHex: req.StakingOutputPkScriptHex,
+		SlashingTxHex:            req.SlashingTxHex,
+		CovenantPublicKey:        req.CovenantPublicKey,
+		Phase2StakingData:        phase2Data,
+	}, nil
+}
+
+func signUnbondingSlashingTxRequestFromPb(
+	req *signerpb.SignUnbondingSlashingTxRequest,
+) (*types.SignUnbondingSlashingTxRequest, *types.Error) {
+	phase2Data, err := phase2StakingDataFromPb(req.Phase2StakingData)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SignUnbondingSlashingTxRequest{
+		StakingOutputPkScriptHex: req.StakingOutputPkScriptHex,
+		UnbondingTxHex:           req.UnbondingTxHex,
+		SlashingTxHex:            req.SlashingTxHex,
+		CovenantPublicKey:        req.CovenantPublicKey,
+		Phase2StakingData:        phase2Data,
+	}, nil
+}
+
+func adaptorSignaturesToPb(sigs []types.FinalityProviderAdaptorSignature) []*signerpb.FinalityProviderAdaptorSignature {
+	result := make([]*signerpb.FinalityProviderAdaptorSignature, 0, len(sigs))
+	for _, sig := range sigs {
+		result = append(result, &signerpb.FinalityProviderAdaptorSignature{
+			FinalityProviderPublicKeyHex: sig.FinalityProviderPublicKeyHex,
+			AdaptorSignatureHex:          sig.AdaptorSignatureHex,
+		})
+	}
+
+	return result
+}
+
+func validateUnbondingTxResponseToPb(resp *types.ValidateUnbondingTxResponse) *signerpb.ValidateUnbondingTxResponse {
+	checks := make([]*signerpb.ValidationCheck, 0, len(resp.Checks))
+	for _, c := range resp.Checks {
+		checks = append(checks, &signerpb.ValidationCheck{
+			Name:   c.Name,
+			Passed: c.Passed,
+			Error:  c.Error,
+		})
+	}
+
+	return &signerpb.ValidateUnbondingTxResponse{
+		Valid:                         resp.Valid,
+		StakerPublicKeyHex:            resp.StakerPublicKeyHex,
+		FinalityProviderPublicKeysHex: resp.FinalityProviderPublicKeysHex,
+		StakingTime:                   uint32(resp.StakingTime),
+		StakingAmountSat:              resp.StakingAmountSat,
+		StakingTxInclusionHeight:      resp.StakingTxInclusionHeight,
+		ParamsVersion:                 resp.ParamsVersion,
+		Confirmations:                 resp.Confirmations,
+		Checks:                        checks,
+	}
+}
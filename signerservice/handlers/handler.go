@@ -2,15 +2,28 @@ package handlers
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"net/http"
+	"sync/atomic"
 
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
 	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/observability/tracing"
 	s "github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/middlewares"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/rs/zerolog"
 )
 
 type Handler struct {
 	s *s.SignerApp
 	m *m.CovenantSignerMetrics
+	// paused rejects signing requests, while validation requests are still
+	// served
+	paused atomic.Bool
 }
 
 type Result struct {
@@ -27,6 +40,193 @@ func NewResult[T any](data T) *Result {
 	return &Result{Data: res, Status: http.StatusOK}
 }
 
+// HandlerFunc handles decoded request independently of the transport it was
+// received with. It is shared by HTTP and gRPC servers.
+type HandlerFunc[Req, Resp any] func(ctx context.Context, payload *Req) (*Resp, *types.Error)
+
+// JSONHandler adapts HandlerFunc to HTTP request with JSON payload
+func JSONHandler[Req, Resp any](f HandlerFunc[Req, Resp]) func(*http.Request) (*Result, *types.Error) {
+	return func(request *http.Request) (*Result, *types.Error) {
+		_, span := tracing.StartSpan(request.Context(), "decode_request")
+		payload := new(Req)
+		err := json.NewDecoder(request.Body).Decode(payload)
+		span.End(err)
+		if err != nil {
+			return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid request payload")
+		}
+
+		resp, respErr := f(request.Context(), payload)
+
+		if respErr != nil {
+			return nil, respErr
+		}
+
+		return NewResult(resp), nil
+	}
+}
+
+// requestResult is label of request duration metric
+func requestResult(err *types.Error) string {
+	switch {
+	case err == nil:
+		return "success"
+	case err.ErrorCode == types.InternalServiceError:
+		return "failure"
+	default:
+		return "rejected"
+	}
+}
+
+// instrument handles the request with f, recording its duration in metrics
+// and in tracing info of the request
+func instrument[Req, Resp any](
+	ctx context.Context,
+	h *Handler,
+	operation string,
+	payload *Req,
+	f HandlerFunc[Req, Resp],
+) (*Resp, *types.Error) {
+	h.m.IncInFlightRequests()
+	defer h.m.DecInFlightRequests()
+
+	ctx, span := tracing.StartSpan(ctx, operation)
+	resp, err := f(ctx, payload)
+	h.m.ObserveRequestDuration(operation, requestResult(err), span.End(spanError(err)))
+
+	return resp, err
+}
+
+// pausable rejects the request with retryable error while signing is paused
+func pausable[Req, Resp any](h *Handler, f HandlerFunc[Req, Resp]) HandlerFunc[Req, Resp] {
+	return func(ctx context.Context, payload *Req) (*Resp, *types.Error) {
+		if h.Paused() {
+			return nil, types.NewErrorWithMsg(http.StatusServiceUnavailable, types.ServicePaused, "signing is paused")
+		}
+
+		return f(ctx, payload)
+	}
+}
+
+// SetPaused pauses or resumes signing
+func (h *Handler) SetPaused(paused bool) {
+	h.paused.Store(paused)
+}
+
+// Paused returns true if signing is paused
+func (h *Handler) Paused() bool {
+	return h.paused.Load()
+}
+
+// spanError converts handler error to error recorded in span. It is needed as
+// nil *types.Error is not nil error.
+func spanError(err *types.Error) error {
+	if err == nil {
+		return nil
+	}
+
+	return err
+}
+
+// parse parses the payload with f in its own span
+func parse[Payload, Req any](
+	ctx context.Context,
+	payload *Payload,
+	f func(*Payload) (*Req, *types.Error),
+) (*Req, *types.Error) {
+	_, span := tracing.StartSpan(ctx, "parse_request")
+	req, err := f(payload)
+	span.End(spanError(err))
+
+	return req, err
+}
+
+// unknownCovenantKey is metric label of covenant keys which are not known
+// committee members, so that clients cannot create unbounded number of series
+const unknownCovenantKey = "unknown"
+
+// stakingOutPoint returns staking output spent by the transaction, nil if the
+// transaction has no inputs
+func stakingOutPoint(tx *wire.MsgTx) *wire.OutPoint {
+	if tx == nil || len(tx.TxIn) == 0 {
+		return nil
+	}
+
+	return &tx.TxIn[0].PreviousOutPoint
+}
+
+// audit records signing decision in the audit log, together with the
+// authenticated client which requested it. Accepted requests are logged at
+// info level, rejected at warn level and failed at error level.
+func audit(
+	ctx context.Context,
+	kind string,
+	covenantPublicKey *btcec.PublicKey,
+	outPoint *wire.OutPoint,
+	err error,
+) {
+	logger := logging.Logger(logging.ComponentAudit)
+
+	var event *zerolog.Event
+	if err == nil {
+		event = logger.Info().Str("result", "accepted")
+	} else if reason, ok := s.RejectionReason(err); ok {
+		event = logger.Warn().Str("result", "rejected").Str("reason", reason).Err(err)
+	} else {
+		event = logger.Error().Str("result", "failed").Err(err)
+	}
+
+	if clientIP := middlewares.ClientIPFromContext(ctx); clientIP.IsValid() {
+		event = event.Str("client_ip", clientIP.String())
+	}
+
+	if outPoint != nil {
+		event = event.Str("staking_outpoint", outPoint.String())
+	}
+
+	event.
+		Str("client", middlewares.ClientIdFromContext(ctx)).
+		Str("kind", kind).
+		Str("covenant_key", hex.EncodeToString(covenantPublicKey.SerializeCompressed())).
+		Msg("signing decision")
+}
+
+// signingSucceeded counts successful signing request and records it in the
+// audit log
+func (h *Handler) signingSucceeded(
+	ctx context.Context,
+	kind string,
+	covenantPublicKey *btcec.PublicKey,
+	outPoint *wire.OutPoint,
+) {
+	h.m.IncSuccessfulSigningRequests()
+	audit(ctx, kind, covenantPublicKey, outPoint, nil)
+}
+
+// signingFailed counts failed signing request, and its rejection reason if
+// the request was rejected, records it in the audit log and maps the error
+// to service error
+func (h *Handler) signingFailed(
+	ctx context.Context,
+	kind string,
+	err error,
+	covenantPublicKey *btcec.PublicKey,
+	outPoint *wire.OutPoint,
+) *types.Error {
+	h.m.IncFailedSigningRequests()
+
+	if reason, ok := s.RejectionReason(err); ok {
+		covenantKey := unknownCovenantKey
+		if h.s.IsKnownCovenantMember(covenantPublicKey) {
+			covenantKey = hex.EncodeToString(covenantPublicKey.SerializeCompressed())
+		}
+		h.m.IncRejectedSigningRequests(reason, covenantKey)
+	}
+
+	audit(ctx, kind, covenantPublicKey, outPoint, err)
+
+	return signingError(err)
+}
+
 func NewHandler(
 	_ context.Context, s *s.SignerApp, m *m.CovenantSignerMetrics,
 ) (*Handler, error) {
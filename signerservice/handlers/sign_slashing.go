@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/babylonlabs-io/covenant-signer/utils"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+)
+
+func adaptorSignaturesResponse(sigs []*signerapp.FinalityProviderAdaptorSignature) []types.FinalityProviderAdaptorSignature {
+	resp := make([]types.FinalityProviderAdaptorSignature, 0, len(sigs))
+	for _, sig := range sigs {
+		resp = append(resp, types.FinalityProviderAdaptorSignature{
+			FinalityProviderPublicKeyHex: hex.EncodeToString(schnorr.SerializePubKey(sig.FinalityProviderPublicKey)),
+			AdaptorSignatureHex:          sig.Signature.MarshalHex(),
+		})
+	}
+
+	return resp
+}
+
+type signSlashingTxRequest struct {
+	pkScript          []byte
+	slashingTx        *wire.MsgTx
+	covenantPublicKey *btcec.PublicKey
+	phase2Data        *signerapp.Phase2StakingData
+}
+
+func parseSignSlashingTxRequest(payload *types.SignSlashingTxRequest) (*signSlashingTxRequest, *types.Error) {
+	pkScript, err := hex.DecodeString(payload.StakingOutputPkScriptHex)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid staking output pk script")
+	}
+
+	covenantPublicKeyBytes, err := hex.DecodeString(payload.CovenantPublicKey)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid covenant public key")
+	}
+
+	covenantPublicKey, err := btcec.ParsePubKey(covenantPublicKeyBytes)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid covenant public key")
+	}
+
+	slashingTx, _, err := utils.NewBTCTxFromHex(payload.SlashingTxHex)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid slashing transaction")
+	}
+
+	phase2Data, parseErr := parsePhase2StakingData(&payload.Phase2StakingData)
+
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	return &signSlashingTxRequest{
+		pkScript:          pkScript,
+		slashingTx:        slashingTx,
+		covenantPublicKey: covenantPublicKey,
+		phase2Data:        phase2Data,
+	}, nil
+}
+
+func (h *Handler) SignSlashing(ctx context.Context, payload *types.SignSlashingTxRequest) (*types.SignSlashingTxResponse, *types.Error) {
+	return instrument(ctx, h, "sign_slashing", payload, pausable(h, h.signSlashing))
+}
+
+func (h *Handler) signSlashing(ctx context.Context, payload *types.SignSlashingTxRequest) (*types.SignSlashingTxResponse, *types.Error) {
+	req, parseErr := parse(ctx, payload, parseSignSlashingTxRequest)
+
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	// do not count the requests with invalid arguments
+	h.m.IncReceivedSigningRequests()
+
+	sigs, err := h.s.SignSlashingTransaction(
+		ctx,
+		req.pkScript,
+		req.slashingTx,
+		req.covenantPublicKey,
+		req.phase2Data,
+	)
+
+	if err != nil {
+		return nil, h.signingFailed(ctx, "sign_slashing", err, req.covenantPublicKey, stakingOutPoint(req.slashingTx))
+	}
+
+	resp := types.SignSlashingTxResponse{
+		AdaptorSignatures: adaptorSignaturesResponse(sigs),
+	}
+
+	h.signingSucceeded(ctx, "sign_slashing", req.covenantPublicKey, stakingOutPoint(req.slashingTx))
+
+	return &resp, nil
+}
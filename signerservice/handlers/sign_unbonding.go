@@ -1,8 +1,8 @@
 package handlers
 
 import (
+	"context"
 	"encoding/hex"
-	"encoding/json"
 	"errors"
 	"net/http"
 
@@ -22,13 +22,62 @@ func parseSchnorrSigFromHex(hexStr string) (*schnorr.Signature, error) {
 	return schnorr.ParseSignature(sigBytes)
 }
 
-func (h *Handler) SignUnbonding(request *http.Request) (*Result, *types.Error) {
-	payload := &types.SignUnbondingTxRequest{}
-	err := json.NewDecoder(request.Body).Decode(payload)
+// parsePhase2StakingData returns nil if request is for phase-1 staking transaction
+func parsePhase2StakingData(payload *types.Phase2StakingData) (*signerapp.Phase2StakingData, *types.Error) {
+	if payload.StakerPublicKeyHex == "" {
+		if len(payload.FinalityProviderPublicKeysHex) > 0 || payload.StakingTime != 0 {
+			return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "missing staker public key")
+		}
+		return nil, nil
+	}
+
+	stakerPublicKey, err := utils.PubKeyFromHex(payload.StakerPublicKeyHex)
+
 	if err != nil {
-		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid request payload")
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid staker public key")
+	}
+
+	if len(payload.FinalityProviderPublicKeysHex) == 0 {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "missing finality provider public keys")
+	}
+
+	fpPublicKeys := make([]*btcec.PublicKey, 0, len(payload.FinalityProviderPublicKeysHex))
+	for _, fpKeyHex := range payload.FinalityProviderPublicKeysHex {
+		fpPublicKey, err := utils.PubKeyFromHex(fpKeyHex)
+
+		if err != nil {
+			return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid finality provider public key")
+		}
+
+		fpPublicKeys = append(fpPublicKeys, fpPublicKey)
+	}
+
+	return &signerapp.Phase2StakingData{
+		StakerPublicKey:            stakerPublicKey,
+		FinalityProviderPublicKeys: fpPublicKeys,
+		StakingTime:                payload.StakingTime,
+	}, nil
+}
+
+// signingError maps errors returned by signer app to service errors
+func signingError(err error) *types.Error {
+	if errors.Is(err, signerapp.ErrInvalidSigningRequest) {
+		return types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, err.Error())
+	}
+
+	if errors.Is(err, signerapp.ErrStakingTxReorged) {
+		return types.NewErrorWithMsg(http.StatusServiceUnavailable, types.ChainReorg, err.Error())
 	}
 
+	if errors.Is(err, signerapp.ErrSigningFrozen) {
+		return types.NewErrorWithMsg(http.StatusServiceUnavailable, types.SigningFrozen, err.Error())
+	}
+
+	// if this is unknown error, return internal server error
+	return types.NewErrorWithMsg(http.StatusInternalServerError, types.InternalServiceError, err.Error())
+}
+
+func parseSignUnbondingTxRequest(payload *types.SignUnbondingTxRequest) (*signerapp.UnbondingSigningRequest, *types.Error) {
 	pkScript, err := hex.DecodeString(payload.StakingOutputPkScriptHex)
 
 	if err != nil {
@@ -59,33 +108,53 @@ func (h *Handler) SignUnbonding(request *http.Request) (*Result, *types.Error) {
 		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid staker unbonding signature")
 	}
 
+	phase2Data, parseErr := parsePhase2StakingData(&payload.Phase2StakingData)
+
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	return &signerapp.UnbondingSigningRequest{
+		StakingOutputPkScript: pkScript,
+		UnbondingTx:           unbondingTx,
+		StakerUnbondingSig:    stakerUnbondingSig,
+		CovenantPublicKey:     covenantPublicKey,
+		Phase2Data:            phase2Data,
+	}, nil
+}
+
+func (h *Handler) SignUnbonding(ctx context.Context, payload *types.SignUnbondingTxRequest) (*types.SignUnbondingTxResponse, *types.Error) {
+	return instrument(ctx, h, "sign_unbonding", payload, pausable(h, h.signUnbonding))
+}
+
+func (h *Handler) signUnbonding(ctx context.Context, payload *types.SignUnbondingTxRequest) (*types.SignUnbondingTxResponse, *types.Error) {
+	req, parseErr := parse(ctx, payload, parseSignUnbondingTxRequest)
+
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
 	// do not count the requests with invalid arguments
 	h.m.IncReceivedSigningRequests()
 
 	sig, err := h.s.SignUnbondingTransaction(
-		request.Context(),
-		pkScript,
-		unbondingTx,
-		stakerUnbondingSig,
-		covenantPublicKey,
+		ctx,
+		req.StakingOutputPkScript,
+		req.UnbondingTx,
+		req.StakerUnbondingSig,
+		req.CovenantPublicKey,
+		req.Phase2Data,
 	)
 
 	if err != nil {
-		h.m.IncFailedSigningRequests()
-
-		if errors.Is(err, signerapp.ErrInvalidSigningRequest) {
-			return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, err.Error())
-		}
-
-		// if this is unknown error, return internal server error
-		return nil, types.NewErrorWithMsg(http.StatusInternalServerError, types.InternalServiceError, err.Error())
+		return nil, h.signingFailed(ctx, "sign_unbonding", err, req.CovenantPublicKey, stakingOutPoint(req.UnbondingTx))
 	}
 
 	resp := types.SignUnbondingTxResponse{
 		SignatureHex: hex.EncodeToString(sig.Serialize()),
 	}
 
-	h.m.IncSuccessfulSigningRequests()
+	h.signingSucceeded(ctx, "sign_unbonding", req.CovenantPublicKey, stakingOutPoint(req.UnbondingTx))
 
-	return NewResult(resp), nil
+	return &resp, nil
 }
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"net/http"
+
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+)
+
+func newItemError(err *types.Error) *types.ItemError {
+	message := err.Err.Error()
+	// Hide the internal message error from client, the same as for single requests
+	if err.ErrorCode == types.InternalServiceError {
+		message = "Internal service error"
+	}
+
+	return &types.ItemError{
+		ErrorCode: err.ErrorCode.String(),
+		Message:   message,
+	}
+}
+
+func (h *Handler) SignUnbondingBatch(ctx context.Context, payload *types.SignUnbondingTxsRequest) (*types.SignUnbondingTxsResponse, *types.Error) {
+	return instrument(ctx, h, "sign_unbonding_batch", payload, pausable(h, h.signUnbondingBatch))
+}
+
+func (h *Handler) signUnbondingBatch(ctx context.Context, payload *types.SignUnbondingTxsRequest) (*types.SignUnbondingTxsResponse, *types.Error) {
+	if len(payload.Requests) == 0 {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "empty batch")
+	}
+
+	results := make([]types.SignUnbondingTxResult, len(payload.Requests))
+
+	// Requests with invalid arguments fail on their own, without failing the
+	// whole batch. Remaining requests are sent to signer app.
+	var (
+		validRequests []*signerapp.UnbondingSigningRequest
+		validIndices  []int
+	)
+	for i := range payload.Requests {
+		req, parseErr := parse(ctx, &payload.Requests[i], parseSignUnbondingTxRequest)
+
+		if parseErr != nil {
+			results[i].Error = newItemError(parseErr)
+			continue
+		}
+
+		validRequests = append(validRequests, req)
+		validIndices = append(validIndices, i)
+	}
+
+	if len(validRequests) > 0 {
+		signingResults, err := h.s.SignUnbondingTransactions(ctx, validRequests)
+
+		if err != nil {
+			if errors.Is(err, signerapp.ErrInvalidSigningRequest) {
+				return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, err.Error())
+			}
+
+			return nil, types.NewErrorWithMsg(http.StatusInternalServerError, types.InternalServiceError, err.Error())
+		}
+
+		for j, result := range signingResults {
+			// do not count the requests with invalid arguments
+			h.m.IncReceivedSigningRequests()
+
+			i := validIndices[j]
+			req := validRequests[j]
+			if result.Err != nil {
+				results[i].Error = newItemError(
+					h.signingFailed(ctx, "sign_unbonding_batch", result.Err, req.CovenantPublicKey, stakingOutPoint(req.UnbondingTx)),
+				)
+				continue
+			}
+
+			h.signingSucceeded(ctx, "sign_unbonding_batch", req.CovenantPublicKey, stakingOutPoint(req.UnbondingTx))
+			results[i].SignatureHex = hex.EncodeToString(result.Signature.Serialize())
+		}
+	}
+
+	return &types.SignUnbondingTxsResponse{Results: results}, nil
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/babylonlabs-io/covenant-signer/utils"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+type signUnbondingByStakingTxRequest struct {
+	stakingTxHash      *chainhash.Hash
+	unbondingTx        *wire.MsgTx
+	stakerUnbondingSig *schnorr.Signature
+	covenantPublicKey  *btcec.PublicKey
+	phase2Data         *signerapp.Phase2StakingData
+}
+
+func parseSignUnbondingByStakingTxRequest(payload *types.SignUnbondingByStakingTxRequest) (*signUnbondingByStakingTxRequest, *types.Error) {
+	stakingTxHash, err := chainhash.NewHashFromStr(payload.StakingTxHashHex)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid staking transaction hash")
+	}
+
+	covenantPublicKeyBytes, err := hex.DecodeString(payload.CovenantPublicKey)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid covenant public key")
+	}
+
+	covenantPublicKey, err := btcec.ParsePubKey(covenantPublicKeyBytes)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid covenant public key")
+	}
+
+	var unbondingTx *wire.MsgTx
+	if payload.UnbondingTxHex != "" {
+		unbondingTx, _, err = utils.NewBTCTxFromHex(payload.UnbondingTxHex)
+
+		if err != nil {
+			return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid unbonding transaction")
+		}
+	}
+
+	stakerUnbondingSig, err := parseSchnorrSigFromHex(payload.StakerUnbondingSigHex)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid staker unbonding signature")
+	}
+
+	phase2Data, parseErr := parsePhase2StakingData(&payload.Phase2StakingData)
+
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	return &signUnbondingByStakingTxRequest{
+		stakingTxHash:      stakingTxHash,
+		unbondingTx:        unbondingTx,
+		stakerUnbondingSig: stakerUnbondingSig,
+		covenantPublicKey:  covenantPublicKey,
+		phase2Data:         phase2Data,
+	}, nil
+}
+
+func (h *Handler) SignUnbondingByStakingTx(ctx context.Context, payload *types.SignUnbondingByStakingTxRequest) (*types.SignUnbondingByStakingTxResponse, *types.Error) {
+	return instrument(ctx, h, "sign_unbonding_by_staking_tx", payload, pausable(h, h.signUnbondingByStakingTx))
+}
+
+func (h *Handler) signUnbondingByStakingTx(ctx context.Context, payload *types.SignUnbondingByStakingTxRequest) (*types.SignUnbondingByStakingTxResponse, *types.Error) {
+	req, parseErr := parse(ctx, payload, parseSignUnbondingByStakingTxRequest)
+
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	// do not count the requests with invalid arguments
+	h.m.IncReceivedSigningRequests()
+
+	outPoint := wire.NewOutPoint(req.stakingTxHash, payload.StakingOutputIndex)
+
+	builtTx, sig, err := h.s.SignUnbondingTransactionByStakingOutpoint(
+		ctx,
+		outPoint,
+		req.unbondingTx,
+		req.stakerUnbondingSig,
+		req.covenantPublicKey,
+		req.phase2Data,
+	)
+
+	if err != nil {
+		return nil, h.signingFailed(ctx, "sign_unbonding_by_staking_tx", err, req.covenantPublicKey, outPoint)
+	}
+
+	unbondingTxHex, err := utils.SerializeBTCTxToHex(builtTx)
+
+	if err != nil {
+		return nil, h.signingFailed(ctx, "sign_unbonding_by_staking_tx", err, req.covenantPublicKey, outPoint)
+	}
+
+	resp := types.SignUnbondingByStakingTxResponse{
+		UnbondingTxHex: unbondingTxHex,
+		SignatureHex:   hex.EncodeToString(sig.Serialize()),
+	}
+
+	h.signingSucceeded(ctx, "sign_unbonding_by_staking_tx", req.covenantPublicKey, outPoint)
+
+	return &resp, nil
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+)
+
+type signUnbondingPsbtRequest struct {
+	packet            *psbt.Packet
+	covenantPublicKey *btcec.PublicKey
+	phase2Data        *signerapp.Phase2StakingData
+}
+
+func parseSignUnbondingPsbtRequest(payload *types.SignUnbondingPsbtRequest) (*signUnbondingPsbtRequest, *types.Error) {
+	packet, err := psbt.NewFromRawBytes(strings.NewReader(payload.PsbtBase64), true)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid psbt packet")
+	}
+
+	covenantPublicKeyBytes, err := hex.DecodeString(payload.CovenantPublicKey)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid covenant public key")
+	}
+
+	covenantPublicKey, err := btcec.ParsePubKey(covenantPublicKeyBytes)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid covenant public key")
+	}
+
+	phase2Data, parseErr := parsePhase2StakingData(&payload.Phase2StakingData)
+
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	return &signUnbondingPsbtRequest{
+		packet:            packet,
+		covenantPublicKey: covenantPublicKey,
+		phase2Data:        phase2Data,
+	}, nil
+}
+
+func (h *Handler) SignUnbondingPsbt(ctx context.Context, payload *types.SignUnbondingPsbtRequest) (*types.SignUnbondingPsbtResponse, *types.Error) {
+	return instrument(ctx, h, "sign_unbonding_psbt", payload, pausable(h, h.signUnbondingPsbt))
+}
+
+func (h *Handler) signUnbondingPsbt(ctx context.Context, payload *types.SignUnbondingPsbtRequest) (*types.SignUnbondingPsbtResponse, *types.Error) {
+	req, parseErr := parse(ctx, payload, parseSignUnbondingPsbtRequest)
+
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	// do not count the requests with invalid arguments
+	h.m.IncReceivedSigningRequests()
+
+	signedPacket, err := h.s.SignUnbondingPsbt(
+		ctx,
+		req.packet,
+		req.covenantPublicKey,
+		req.phase2Data,
+	)
+
+	outPoint := stakingOutPoint(req.packet.UnsignedTx)
+
+	if err != nil {
+		return nil, h.signingFailed(ctx, "sign_unbonding_psbt", err, req.covenantPublicKey, outPoint)
+	}
+
+	signedPacketBase64, err := signedPacket.B64Encode()
+
+	if err != nil {
+		return nil, h.signingFailed(ctx, "sign_unbonding_psbt", err, req.covenantPublicKey, outPoint)
+	}
+
+	resp := types.SignUnbondingPsbtResponse{
+		PsbtBase64: signedPacketBase64,
+	}
+
+	h.signingSucceeded(ctx, "sign_unbonding_psbt", req.covenantPublicKey, outPoint)
+
+	return &resp, nil
+}
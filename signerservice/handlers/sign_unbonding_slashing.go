@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/babylonlabs-io/covenant-signer/utils"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+)
+
+type signUnbondingSlashingTxRequest struct {
+	pkScript          []byte
+	unbondingTx       *wire.MsgTx
+	slashingTx        *wire.MsgTx
+	covenantPublicKey *btcec.PublicKey
+	phase2Data        *signerapp.Phase2StakingData
+}
+
+func parseSignUnbondingSlashingTxRequest(payload *types.SignUnbondingSlashingTxRequest) (*signUnbondingSlashingTxRequest, *types.Error) {
+	pkScript, err := hex.DecodeString(payload.StakingOutputPkScriptHex)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid staking output pk script")
+	}
+
+	covenantPublicKeyBytes, err := hex.DecodeString(payload.CovenantPublicKey)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid covenant public key")
+	}
+
+	covenantPublicKey, err := btcec.ParsePubKey(covenantPublicKeyBytes)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid covenant public key")
+	}
+
+	unbondingTx, _, err := utils.NewBTCTxFromHex(payload.UnbondingTxHex)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid unbonding transaction")
+	}
+
+	slashingTx, _, err := utils.NewBTCTxFromHex(payload.SlashingTxHex)
+
+	if err != nil {
+		return nil, types.NewErrorWithMsg(http.StatusBadRequest, types.BadRequest, "invalid slashing transaction")
+	}
+
+	phase2Data, parseErr := parsePhase2StakingData(&payload.Phase2StakingData)
+
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	return &signUnbondingSlashingTxRequest{
+		pkScript:          pkScript,
+		unbondingTx:       unbondingTx,
+		slashingTx:        slashingTx,
+		covenantPublicKey: covenantPublicKey,
+		phase2Data:        phase2Data,
+	}, nil
+}
+
+func (h *Handler) SignUnbondingSlashing(ctx context.Context, payload *types.SignUnbondingSlashingTxRequest) (*types.SignUnbondingSlashingTxResponse, *types.Error) {
+	return instrument(ctx, h, "sign_unbonding_slashing", payload, pausable(h, h.signUnbondingSlashing))
+}
+
+func (h *Handler) signUnbondingSlashing(ctx context.Context, payload *types.SignUnbondingSlashingTxRequest) (*types.SignUnbondingSlashingTxResponse, *types.Error) {
+	req, parseErr := parse(ctx, payload, parseSignUnbondingSlashingTxRequest)
+
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	// do not count the requests with invalid arguments
+	h.m.IncReceivedSigningRequests()
+
+	sigs, err := h.s.SignUnbondingSlashingTransaction(
+		ctx,
+		req.pkScript,
+		req.unbondingTx,
+		req.slashingTx,
+		req.covenantPublicKey,
+		req.phase2Data,
+	)
+
+	// slashing transaction spends unbonding output, staking output is spent
+	// by the unbonding transaction
+	outPoint := stakingOutPoint(req.unbondingTx)
+
+	if err != nil {
+		return nil, h.signingFailed(ctx, "sign_unbonding_slashing", err, req.covenantPublicKey, outPoint)
+	}
+
+	resp := types.SignUnbondingSlashingTxResponse{
+		AdaptorSignatures: adaptorSignaturesResponse(sigs),
+	}
+
+	h.signingSucceeded(ctx, "sign_unbonding_slashing", req.covenantPublicKey, outPoint)
+
+	return &resp, nil
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+)
+
+func validationReportResponse(report *signerapp.UnbondingValidationReport) *types.ValidateUnbondingTxResponse {
+	resp := &types.ValidateUnbondingTxResponse{
+		Valid:                    report.Valid(),
+		StakingTime:              report.StakingTime,
+		StakingAmountSat:         int64(report.StakingAmount),
+		StakingTxInclusionHeight: report.StakingTxInclusionHeight,
+		ParamsVersion:            report.ParamsVersion,
+		Confirmations:            report.Confirmations,
+		Checks:                   make([]types.ValidationCheck, 0, len(report.Checks)),
+	}
+
+	if report.StakerPublicKey != nil {
+		resp.StakerPublicKeyHex = hex.EncodeToString(schnorr.SerializePubKey(report.StakerPublicKey))
+	}
+
+	for _, fpKey := range report.FinalityProviderPublicKeys {
+		resp.FinalityProviderPublicKeysHex = append(
+			resp.FinalityProviderPublicKeysHex,
+			hex.EncodeToString(schnorr.SerializePubKey(fpKey)),
+		)
+	}
+
+	for _, c := range report.Checks {
+		check := types.ValidationCheck{
+			Name:   c.Name,
+			Passed: c.Passed,
+		}
+		if c.Err != nil {
+			check.Error = c.Err.Error()
+		}
+		resp.Checks = append(resp.Checks, check)
+	}
+
+	return resp
+}
+
+// ValidateUnbonding validates unbonding signing request without signing it.
+// Failed checks are part of the successful response, errors are returned
+// only for malformed requests or if the validation could not be done.
+func (h *Handler) ValidateUnbonding(ctx context.Context, payload *types.SignUnbondingTxRequest) (*types.ValidateUnbondingTxResponse, *types.Error) {
+	return instrument(ctx, h, "validate_unbonding", payload, h.validateUnbonding)
+}
+
+func (h *Handler) validateUnbonding(ctx context.Context, payload *types.SignUnbondingTxRequest) (*types.ValidateUnbondingTxResponse, *types.Error) {
+	req, parseErr := parse(ctx, payload, parseSignUnbondingTxRequest)
+
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	report, err := h.s.ValidateUnbondingTransaction(
+		ctx,
+		req.StakingOutputPkScript,
+		req.UnbondingTx,
+		req.StakerUnbondingSig,
+		req.CovenantPublicKey,
+		req.Phase2Data,
+	)
+
+	if err != nil {
+		return nil, signingError(err)
+	}
+
+	return validationReportResponse(report), nil
+}
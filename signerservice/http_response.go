@@ -45,7 +45,11 @@ func registerHandler(handlerFunc func(*http.Request) (*handlers.Result, *types.E
 			// Log the error
 			if err.StatusCode >= http.StatusInternalServerError {
 				logger.Ctx(r.Context()).Error().Err(errorResponse).Msg("request failed with 5xx error")
-				errorResponse.Message = "Internal service error" // Hide the internal message error from client
+				// Hide the internal message error from client, retryable errors
+				// are kept as they are meaningful to the caller
+				if err.ErrorCode == types.InternalServiceError {
+					errorResponse.Message = "Internal service error"
+				}
 			}
 			// terminate the request here
 			writeResponse(w, r, err.StatusCode, errorResponse)
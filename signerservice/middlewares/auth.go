@@ -0,0 +1,358 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	ApiKeyHeader    = "X-Api-Key"
+	ClientIdHeader  = "X-Client-Id"
+	TimestampHeader = "X-Timestamp"
+	NonceHeader     = "X-Nonce"
+	SignatureHeader = "X-Signature"
+
+	maxNonceLength = 64
+)
+
+var (
+	ErrNoCredentials      = errors.New("missing credentials")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrExpiredRequest     = errors.New("request timestamp is outside of allowed clock skew")
+	ErrReplayedRequest    = errors.New("request nonce was already used")
+)
+
+type clientIdKey struct{}
+
+// ClientIdFromContext returns id of authenticated client, empty string if the
+// request was not authenticated
+func ClientIdFromContext(ctx context.Context) string {
+	clientId, _ := ctx.Value(clientIdKey{}).(string)
+	return clientId
+}
+
+// AuthRequest is transport independent view of the request used for
+// authentication. For gRPC requests, path is full method name and body is
+// deterministic protobuf encoding of the request message.
+type AuthRequest struct {
+	Method string
+	Path   string
+	Header http.Header
+	Body   []byte
+}
+
+// Authenticator authenticates requests carrying one kind of credentials
+type Authenticator interface {
+	// Authenticate returns id of the client which sent the request.
+	// ErrNoCredentials is returned if the request does not carry credentials
+	// checked by this authenticator.
+	Authenticate(req *AuthRequest) (string, error)
+}
+
+// authenticate tries authenticators in order, the first one which finds its
+// credentials in the request decides
+func authenticate(authenticators []Authenticator, req *AuthRequest) (string, error) {
+	for _, a := range authenticators {
+		clientId, err := a.Authenticate(req)
+
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+
+		return clientId, err
+	}
+
+	return "", ErrNoCredentials
+}
+
+// authFailureReason is label of authentication failures metric
+func authFailureReason(err error) string {
+	switch {
+	case errors.Is(err, ErrNoCredentials):
+		return "missing_credentials"
+	case errors.Is(err, ErrExpiredRequest):
+		return "expired"
+	case errors.Is(err, ErrReplayedRequest):
+		return "replayed"
+	default:
+		return "invalid_credentials"
+	}
+}
+
+// ApiKeyAuthenticator authenticates requests with static api key sent in
+// X-Api-Key header. Only sha256 hashes of the keys are stored.
+type ApiKeyAuthenticator struct {
+	keyHashes map[[sha256.Size]byte]string
+}
+
+func NewApiKeyAuthenticator(keyHashes map[[sha256.Size]byte]string) *ApiKeyAuthenticator {
+	return &ApiKeyAuthenticator{keyHashes: keyHashes}
+}
+
+func (a *ApiKeyAuthenticator) Authenticate(req *AuthRequest) (string, error) {
+	key := req.Header.Get(ApiKeyHeader)
+	if key == "" {
+		return "", ErrNoCredentials
+	}
+
+	clientId, ok := a.keyHashes[sha256.Sum256([]byte(key))]
+	if !ok {
+		return "", ErrInvalidCredentials
+	}
+
+	return clientId, nil
+}
+
+// SignedRequestMessage returns message signed by clients sending signed
+// requests
+func SignedRequestMessage(req *AuthRequest, clientId, timestamp, nonce string) []byte {
+	bodyHash := sha256.Sum256(req.Body)
+	return []byte(fmt.Sprintf(
+		"%s\n%s\n%s\n%s\n%s\n%s",
+		req.Method, req.Path, clientId, timestamp, nonce, hex.EncodeToString(bodyHash[:]),
+	))
+}
+
+// SignatureAuthenticator authenticates requests signed with HMAC-SHA256 or
+// Ed25519 key of the client. Signed requests carry client id, unix timestamp
+// in seconds, random nonce and hex encoded signature over
+// SignedRequestMessage in headers. Requests with timestamp outside of max
+// clock skew or with already used nonce are rejected.
+type SignatureAuthenticator struct {
+	hmacKeys     map[string][]byte
+	ed25519Keys  map[string]ed25519.PublicKey
+	maxClockSkew time.Duration
+	now          func() time.Time
+
+	mu sync.Mutex
+	// nonces maps client id and nonce to time after which the request with
+	// the nonce is expired and the nonce can be forgotten
+	nonces    map[string]time.Time
+	lastPrune time.Time
+}
+
+func NewSignatureAuthenticator(
+	hmacKeys map[string][]byte,
+	ed25519Keys map[string]ed25519.PublicKey,
+	maxClockSkew time.Duration,
+	now func() time.Time,
+) *SignatureAuthenticator {
+	return &SignatureAuthenticator{
+		hmacKeys:     hmacKeys,
+		ed25519Keys:  ed25519Keys,
+		maxClockSkew: maxClockSkew,
+		now:          now,
+		nonces:       make(map[string]time.Time),
+	}
+}
+
+func (a *SignatureAuthenticator) Authenticate(req *AuthRequest) (string, error) {
+	clientId := req.Header.Get(ClientIdHeader)
+	signatureHex := req.Header.Get(SignatureHeader)
+	if clientId == "" && signatureHex == "" {
+		return "", ErrNoCredentials
+	}
+
+	timestamp := req.Header.Get(TimestampHeader)
+	nonce := req.Header.Get(NonceHeader)
+	if clientId == "" || signatureHex == "" || timestamp == "" || nonce == "" || len(nonce) > maxNonceLength {
+		return "", ErrInvalidCredentials
+	}
+
+	signature, err := hex.DecodeString(signatureHex)
+	if err != nil {
+		return "", ErrInvalidCredentials
+	}
+
+	msg := SignedRequestMessage(req, clientId, timestamp, nonce)
+
+	if secret, ok := a.hmacKeys[clientId]; ok {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write(msg)
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return "", ErrInvalidCredentials
+		}
+	} else if pubKey, ok := a.ed25519Keys[clientId]; ok {
+		if !ed25519.Verify(pubKey, msg, signature) {
+			return "", ErrInvalidCredentials
+		}
+	} else {
+		return "", ErrInvalidCredentials
+	}
+
+	// timestamp and nonce are checked only for requests with valid signature,
+	// so that nonces cannot be consumed by other clients
+	unixTime, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return "", ErrInvalidCredentials
+	}
+
+	requestTime := time.Unix(unixTime, 0)
+	now := a.now()
+	if requestTime.Before(now.Add(-a.maxClockSkew)) || requestTime.After(now.Add(a.maxClockSkew)) {
+		return "", ErrExpiredRequest
+	}
+
+	if !a.useNonce(clientId+"\n"+nonce, requestTime.Add(a.maxClockSkew), now) {
+		return "", ErrReplayedRequest
+	}
+
+	return clientId, nil
+}
+
+// useNonce returns false if the nonce was already used
+func (a *SignatureAuthenticator) useNonce(key string, expiry time.Time, now time.Time) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if now.Sub(a.lastPrune) > a.maxClockSkew {
+		for k, e := range a.nonces {
+			if now.After(e) {
+				delete(a.nonces, k)
+			}
+		}
+		a.lastPrune = now
+	}
+
+	if _, ok := a.nonces[key]; ok {
+		return false
+	}
+
+	a.nonces[key] = expiry
+	return true
+}
+
+// RequestSigner adds credentials to requests sent by the client
+type RequestSigner interface {
+	SignRequest(req *AuthRequest) error
+}
+
+type apiKeySigner struct {
+	key string
+}
+
+// NewApiKeyRequestSigner returns signer adding api key to requests
+func NewApiKeyRequestSigner(key string) RequestSigner {
+	return &apiKeySigner{key: key}
+}
+
+func (s *apiKeySigner) SignRequest(req *AuthRequest) error {
+	req.Header.Set(ApiKeyHeader, s.key)
+	return nil
+}
+
+type messageSigner struct {
+	clientId string
+	sign     func(msg []byte) []byte
+}
+
+// NewHmacRequestSigner returns signer adding HMAC-SHA256 signature to requests
+func NewHmacRequestSigner(clientId string, secret []byte) RequestSigner {
+	return &messageSigner{
+		clientId: clientId,
+		sign: func(msg []byte) []byte {
+			mac := hmac.New(sha256.New, secret)
+			mac.Write(msg)
+			return mac.Sum(nil)
+		},
+	}
+}
+
+// NewEd25519RequestSigner returns signer adding Ed25519 signature to requests
+func NewEd25519RequestSigner(clientId string, key ed25519.PrivateKey) RequestSigner {
+	return &messageSigner{
+		clientId: clientId,
+		sign: func(msg []byte) []byte {
+			return ed25519.Sign(key, msg)
+		},
+	}
+}
+
+func (s *messageSigner) SignRequest(req *AuthRequest) error {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	nonceHex := hex.EncodeToString(nonce)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	signature := s.sign(SignedRequestMessage(req, s.clientId, timestamp, nonceHex))
+
+	req.Header.Set(ClientIdHeader, s.clientId)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(NonceHeader, nonceHex)
+	req.Header.Set(SignatureHeader, hex.EncodeToString(signature))
+	return nil
+}
+
+// withClientId attaches authenticated client id to the context and to the
+// logger of the request
+func withClientId(ctx context.Context, clientId string) context.Context {
+	zerolog.Ctx(ctx).UpdateContext(func(c zerolog.Context) zerolog.Context {
+		return c.Str("client", clientId)
+	})
+	return context.WithValue(ctx, clientIdKey{}, clientId)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, errorCode types.ErrorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	// the same format as error responses of handlers
+	_ = json.NewEncoder(w).Encode(struct {
+		ErrorCode string `json:"errorCode"`
+		Message   string `json:"message"`
+	}{
+		ErrorCode: errorCode.String(),
+		Message:   message,
+	})
+}
+
+// AuthMiddleware rejects requests which are not authenticated by any of the
+// authenticators. It must be used after ContentLengthMiddleware, as the whole
+// body is read to verify signed requests.
+func AuthMiddleware(authenticators []Authenticator, metrics *m.CovenantSignerMetrics) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, "Request Entity Too Large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			clientId, err := authenticate(authenticators, &AuthRequest{
+				Method: r.Method,
+				Path:   r.URL.Path,
+				Header: r.Header,
+				Body:   body,
+			})
+
+			if err != nil {
+				metrics.IncAuthenticationFailures(authFailureReason(err))
+				zerolog.Ctx(r.Context()).Warn().Err(err).Msg("request authentication failed")
+				writeError(w, http.StatusUnauthorized, types.Unauthorized, err.Error())
+				return
+			}
+
+			metrics.IncAuthenticatedRequests(clientId)
+			next.ServeHTTP(w, r.WithContext(withClientId(r.Context(), clientId)))
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package middlewares_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/middlewares"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testPath = "/v1/sign-unbonding-tx"
+
+var testSecret = bytes.Repeat([]byte{1}, 32)
+
+type testAuth struct {
+	handler http.Handler
+	now     time.Time
+	// client ids seen by the handler behind the middleware
+	clientIds []string
+}
+
+func newTestAuth(edKey ed25519.PublicKey) *testAuth {
+	a := &testAuth{now: time.Unix(1700000000, 0)}
+
+	authenticators := []middlewares.Authenticator{
+		middlewares.NewApiKeyAuthenticator(map[[sha256.Size]byte]string{
+			sha256.Sum256([]byte("secret-api-key")): "api-client",
+		}),
+		middlewares.NewSignatureAuthenticator(
+			map[string][]byte{"hmac-client": testSecret},
+			map[string]ed25519.PublicKey{"ed-client": edKey},
+			30*time.Second,
+			func() time.Time { return a.now },
+		),
+	}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.clientIds = append(a.clientIds, middlewares.ClientIdFromContext(r.Context()))
+		w.WriteHeader(http.StatusOK)
+	})
+	a.handler = middlewares.AuthMiddleware(authenticators, m.NewCovenantSignerMetrics())(next)
+
+	return a
+}
+
+func (a *testAuth) send(body []byte, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, testPath, bytes.NewReader(body))
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	rec := httptest.NewRecorder()
+	a.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func signedHeader(t *testing.T, signer middlewares.RequestSigner, body []byte) http.Header {
+	req := &middlewares.AuthRequest{
+		Method: http.MethodPost,
+		Path:   testPath,
+		Header: http.Header{},
+		Body:   body,
+	}
+	require.NoError(t, signer.SignRequest(req))
+	return req.Header
+}
+
+func requireUnauthorized(t *testing.T, rec *httptest.ResponseRecorder) {
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	var resp struct {
+		ErrorCode string `json:"errorCode"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, types.Unauthorized.String(), resp.ErrorCode)
+}
+
+func TestApiKeyAuthentication(t *testing.T) {
+	a := newTestAuth(nil)
+	body := []byte(`{}`)
+
+	rec := a.send(body, http.Header{middlewares.ApiKeyHeader: {"secret-api-key"}})
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, []string{"api-client"}, a.clientIds)
+
+	requireUnauthorized(t, a.send(body, http.Header{middlewares.ApiKeyHeader: {"other-key"}}))
+	requireUnauthorized(t, a.send(body, nil))
+}
+
+func TestSignedRequestAuthentication(t *testing.T) {
+	edPub, edPriv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	a := newTestAuth(edPub)
+	a.now = time.Now()
+	body := []byte(`{"unbonding_tx_hex":"00"}`)
+
+	hmacHeader := signedHeader(t, middlewares.NewHmacRequestSigner("hmac-client", testSecret), body)
+	require.Equal(t, http.StatusOK, a.send(body, hmacHeader).Code)
+
+	edHeader := signedHeader(t, middlewares.NewEd25519RequestSigner("ed-client", edPriv), body)
+	require.Equal(t, http.StatusOK, a.send(body, edHeader).Code)
+	require.Equal(t, []string{"hmac-client", "ed-client"}, a.clientIds)
+
+	// the same request cannot be sent twice
+	requireUnauthorized(t, a.send(body, hmacHeader))
+
+	// signature does not cover modified body
+	header := signedHeader(t, middlewares.NewHmacRequestSigner("hmac-client", testSecret), body)
+	requireUnauthorized(t, a.send([]byte(`{"unbonding_tx_hex":"01"}`), header))
+
+	// signed with key of other client
+	header = signedHeader(t, middlewares.NewHmacRequestSigner("ed-client", testSecret), body)
+	requireUnauthorized(t, a.send(body, header))
+}
+
+func TestSignedRequestOutsideClockSkewIsRejected(t *testing.T) {
+	a := newTestAuth(nil)
+	body := []byte(`{}`)
+
+	header := signedHeader(t, middlewares.NewHmacRequestSigner("hmac-client", testSecret), body)
+	timestamp, err := strconv.ParseInt(header.Get(middlewares.TimestampHeader), 10, 64)
+	require.NoError(t, err)
+
+	a.now = time.Unix(timestamp, 0).Add(time.Minute)
+	requireUnauthorized(t, a.send(body, header))
+	require.Empty(t, a.clientIds)
+}
@@ -0,0 +1,279 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/observability/tracing"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// GrpcErrorDomain is domain of google.rpc.ErrorInfo attached to gRPC errors
+const GrpcErrorDomain = "covenant-signer"
+
+// NewGrpcError returns gRPC status error with error code attached as reason
+// of google.rpc.ErrorInfo detail
+func NewGrpcError(code codes.Code, errorCode types.ErrorCode, message string) error {
+	st, err := status.New(code, message).WithDetails(&errdetails.ErrorInfo{
+		Reason: errorCode.String(),
+		Domain: GrpcErrorDomain,
+	})
+
+	if err != nil {
+		return status.Error(code, message)
+	}
+
+	return st.Err()
+}
+
+// metadataCarrier adapts gRPC metadata for propagation of trace context
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// isServerError reports whether gRPC error code means failure of the signer
+// rather than invalid request
+func isServerError(code codes.Code) bool {
+	switch code {
+	case codes.Internal, codes.Unknown, codes.Unavailable, codes.DataLoss:
+		return true
+	default:
+		return false
+	}
+}
+
+// TracingInterceptor is gRPC equivalent of TracingMiddleware. Trace context
+// of the client is read from traceparent metadata.
+func TracingInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = tracing.Extract(ctx, metadataCarrier(md))
+	ctx, span := tracing.StartRequest(
+		ctx,
+		info.FullMethod,
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.method", info.FullMethod),
+	)
+
+	resp, err := handler(ctx, req)
+
+	span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(status.Code(err))))
+	if isServerError(status.Code(err)) {
+		span.End(err)
+	} else {
+		span.End(nil)
+	}
+
+	return resp, err
+}
+
+// codeLevel is gRPC equivalent of statusLevel
+func codeLevel(code codes.Code) zerolog.Level {
+	switch {
+	case code == codes.OK:
+		return zerolog.InfoLevel
+	case isServerError(code):
+		return zerolog.ErrorLevel
+	default:
+		return zerolog.WarnLevel
+	}
+}
+
+// LoggingInterceptor is gRPC equivalent of LoggingMiddleware
+func LoggingInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	startTime := time.Now()
+	logger := logging.Logger(logging.ComponentServer).With().Str("method", info.FullMethod).Logger()
+
+	// Attach traceId into each log within the request chain
+	traceId := ctx.Value(tracing.TraceIdKey)
+	if traceId != nil {
+		logger = logger.With().Interface("traceId", traceId).Logger()
+	}
+
+	logger.Debug().Msg("request received")
+	ctx = logger.WithContext(ctx)
+
+	resp, err := handler(ctx, req)
+
+	requestDuration := time.Since(startTime).Milliseconds()
+	// logger from the context contains fields added by following
+	// interceptors e.g. authenticated client
+	logEvent := zerolog.Ctx(ctx).WithLevel(codeLevel(status.Code(err)))
+
+	tracingInfo := ctx.Value(tracing.TraceInfoKey)
+	if tracingInfo != nil {
+		logEvent = logEvent.Interface("tracingInfo", tracingInfo)
+	}
+
+	logEvent.
+		Str("code", status.Code(err).String()).
+		Interface("requestDuration", requestDuration).
+		Msg("Request completed")
+
+	return resp, err
+}
+
+// MessageSizeInterceptor is gRPC equivalent of ContentLengthMiddleware. It
+// rejects requests larger than the limit of their method, methods without
+// a limit use defaultMaxBytes.
+func MessageSizeInterceptor(defaultMaxBytes int64, maxBytes map[string]int64) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		limit, ok := maxBytes[info.FullMethod]
+		if !ok {
+			limit = defaultMaxBytes
+		}
+
+		if msg, ok := req.(proto.Message); ok && int64(proto.Size(msg)) > limit {
+			return nil, status.Error(codes.ResourceExhausted, "Request Entity Too Large")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// grpcAuthRequest builds authentication view of gRPC request. Method is always
+// POST, the same as for http requests.
+func grpcAuthRequest(fullMethod string, md metadata.MD, req any) (*AuthRequest, error) {
+	header := make(http.Header, len(md))
+	for k, v := range md {
+		header[http.CanonicalHeaderKey(k)] = v
+	}
+
+	authReq := &AuthRequest{
+		Method: http.MethodPost,
+		Path:   fullMethod,
+		Header: header,
+	}
+
+	if msg, ok := req.(proto.Message); ok {
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		authReq.Body = body
+	}
+
+	return authReq, nil
+}
+
+// AuthInterceptor is gRPC equivalent of AuthMiddleware. Credentials are read
+// from request metadata.
+func AuthInterceptor(authenticators []Authenticator, metrics *m.CovenantSignerMetrics) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		authReq, err := grpcAuthRequest(info.FullMethod, md, req)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid request message")
+		}
+
+		clientId, err := authenticate(authenticators, authReq)
+
+		if err != nil {
+			metrics.IncAuthenticationFailures(authFailureReason(err))
+			zerolog.Ctx(ctx).Warn().Err(err).Msg("request authentication failed")
+			return nil, NewGrpcError(codes.Unauthenticated, types.Unauthorized, err.Error())
+		}
+
+		metrics.IncAuthenticatedRequests(clientId)
+		return handler(withClientId(ctx, clientId), req)
+	}
+}
+
+// AuthClientInterceptor adds credentials created by the signer to metadata of
+// outgoing gRPC requests
+func AuthClientInterceptor(signer RequestSigner) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		authReq, err := grpcAuthRequest(method, nil, req)
+		if err != nil {
+			return err
+		}
+
+		if err := signer.SignRequest(authReq); err != nil {
+			return err
+		}
+
+		for k, v := range authReq.Header {
+			for _, value := range v {
+				ctx = metadata.AppendToOutgoingContext(ctx, k, value)
+			}
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// TracingClientInterceptor adds trace context of outgoing gRPC requests to
+// their metadata, so that the signer continues the trace of the client
+func TracingClientInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply any,
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	tracing.Inject(ctx, metadataCarrier(md))
+
+	return invoker(metadata.NewOutgoingContext(ctx, md), method, req, reply, cc, opts...)
+}
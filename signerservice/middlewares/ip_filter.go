@@ -0,0 +1,141 @@
+package middlewares
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const ForwardedForHeader = "X-Forwarded-For"
+
+type clientIPKey struct{}
+
+// ClientIPFromContext returns address of the client resolved by IPFilter,
+// invalid address if the filter is not used
+func ClientIPFromContext(ctx context.Context) netip.Addr {
+	addr, _ := ctx.Value(clientIPKey{}).(netip.Addr)
+	return addr
+}
+
+// IPFilter rejects requests from addresses outside of allowed ranges. If the
+// request is sent by trusted proxy, address of the client is read from
+// X-Forwarded-For header.
+type IPFilter struct {
+	// empty if all addresses are allowed
+	allowed        []netip.Prefix
+	trustedProxies []netip.Prefix
+}
+
+func NewIPFilter(allowed []netip.Prefix, trustedProxies []netip.Prefix) *IPFilter {
+	return &IPFilter{
+		allowed:        allowed,
+		trustedProxies: trustedProxies,
+	}
+}
+
+func containsAddr(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, p := range prefixes {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseAddr(s string) (netip.Addr, bool) {
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		s = host
+	}
+
+	addr, err := netip.ParseAddr(strings.TrimSpace(s))
+	if err != nil {
+		return netip.Addr{}, false
+	}
+
+	return addr.Unmap(), true
+}
+
+// ClientIP returns address of the client which sent the request from
+// remoteAddr. X-Forwarded-For values are used only while the hop which added
+// them is trusted proxy, so that clients cannot spoof their address.
+func (f *IPFilter) ClientIP(remoteAddr string, forwardedFor []string) (netip.Addr, bool) {
+	addr, ok := parseAddr(remoteAddr)
+	if !ok {
+		return netip.Addr{}, false
+	}
+
+	var hops []string
+	for _, v := range forwardedFor {
+		hops = append(hops, strings.Split(v, ",")...)
+	}
+
+	// the last hop is added by the proxy closest to the signer
+	for i := len(hops) - 1; i >= 0 && containsAddr(f.trustedProxies, addr); i-- {
+		hop, ok := parseAddr(hops[i])
+		if !ok {
+			return netip.Addr{}, false
+		}
+		addr = hop
+	}
+
+	return addr, true
+}
+
+func (f *IPFilter) allow(addr netip.Addr) bool {
+	return len(f.allowed) == 0 || containsAddr(f.allowed, addr)
+}
+
+// Middleware rejects requests from not allowed addresses with 403 status code
+func (f *IPFilter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		addr, ok := f.ClientIP(r.RemoteAddr, r.Header.Values(ForwardedForHeader))
+
+		if !ok || !f.allow(addr) {
+			zerolog.Ctx(r.Context()).Warn().
+				Str("remote_addr", r.RemoteAddr).
+				Strs("forwarded_for", r.Header.Values(ForwardedForHeader)).
+				Msg("request from not allowed address")
+			writeError(w, http.StatusForbidden, types.Forbidden, "address is not allowed")
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIPKey{}, addr)))
+	})
+}
+
+// Interceptor is gRPC equivalent of Middleware, X-Forwarded-For is read from
+// request metadata
+func (f *IPFilter) Interceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	var remoteAddr string
+	if p, ok := peer.FromContext(ctx); ok {
+		remoteAddr = p.Addr.String()
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	forwardedFor := md.Get(ForwardedForHeader)
+
+	addr, ok := f.ClientIP(remoteAddr, forwardedFor)
+
+	if !ok || !f.allow(addr) {
+		zerolog.Ctx(ctx).Warn().
+			Str("remote_addr", remoteAddr).
+			Strs("forwarded_for", forwardedFor).
+			Msg("request from not allowed address")
+		return nil, NewGrpcError(codes.PermissionDenied, types.Forbidden, "address is not allowed")
+	}
+
+	return handler(context.WithValue(ctx, clientIPKey{}, addr), req)
+}
@@ -0,0 +1,89 @@
+package middlewares_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/babylonlabs-io/covenant-signer/signerservice/middlewares"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/stretchr/testify/require"
+)
+
+type testIPFilter struct {
+	handler http.Handler
+	// client addresses seen by the handler behind the middleware
+	clientIPs []string
+}
+
+func newTestIPFilter(allowed []string, trustedProxies []string) *testIPFilter {
+	f := &testIPFilter{}
+
+	parse := func(cidrs []string) []netip.Prefix {
+		var prefixes []netip.Prefix
+		for _, c := range cidrs {
+			prefixes = append(prefixes, netip.MustParsePrefix(c))
+		}
+		return prefixes
+	}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.clientIPs = append(f.clientIPs, middlewares.ClientIPFromContext(r.Context()).String())
+		w.WriteHeader(http.StatusOK)
+	})
+	f.handler = middlewares.NewIPFilter(parse(allowed), parse(trustedProxies)).Middleware(next)
+
+	return f
+}
+
+func (f *testIPFilter) send(remoteAddr string, forwardedFor ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, testPath, bytes.NewReader([]byte(`{}`)))
+	req.RemoteAddr = remoteAddr
+	for _, v := range forwardedFor {
+		req.Header.Add(middlewares.ForwardedForHeader, v)
+	}
+
+	rec := httptest.NewRecorder()
+	f.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func requireForbidden(t *testing.T, rec *httptest.ResponseRecorder) {
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	var resp struct {
+		ErrorCode string `json:"errorCode"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, types.Forbidden.String(), resp.ErrorCode)
+}
+
+func TestIPFilterAllowedCidrs(t *testing.T) {
+	f := newTestIPFilter([]string{"10.0.0.0/24", "2001:db8::/32"}, nil)
+
+	require.Equal(t, http.StatusOK, f.send("10.0.0.7:1000").Code)
+	require.Equal(t, http.StatusOK, f.send("[2001:db8::1]:1000").Code)
+	requireForbidden(t, f.send("10.0.1.7:1000"))
+
+	// header is ignored if the request is not sent by trusted proxy
+	requireForbidden(t, f.send("192.168.0.1:1000", "10.0.0.7"))
+	require.Equal(t, []string{"10.0.0.7", "2001:db8::1"}, f.clientIPs)
+}
+
+func TestIPFilterTrustedProxies(t *testing.T) {
+	f := newTestIPFilter([]string{"10.0.0.0/24"}, []string{"172.16.0.0/16"})
+
+	require.Equal(t, http.StatusOK, f.send("172.16.0.1:1000", "10.0.0.7").Code)
+	// chain of trusted proxies, multiple header values
+	require.Equal(t, http.StatusOK, f.send("172.16.0.1:1000", "10.0.0.8, 172.16.0.2", "172.16.0.3").Code)
+	// client cannot spoof its address by adding it to the header
+	requireForbidden(t, f.send("172.16.0.1:1000", "10.0.0.7, 192.168.0.1"))
+	requireForbidden(t, f.send("172.16.0.1:1000", "not-an-address"))
+	// proxy itself is not allowed
+	requireForbidden(t, f.send("172.16.0.1:1000"))
+
+	require.Equal(t, []string{"10.0.0.7", "10.0.0.8"}, f.clientIPs)
+}
@@ -4,15 +4,31 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
 	"github.com/babylonlabs-io/covenant-signer/observability/tracing"
+	"github.com/go-chi/chi/v5/middleware"
 
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 )
 
+// statusLevel returns level of request completion log. Rejected requests are
+// logged as warnings and failed requests as errors, so that they are kept when
+// successful requests are filtered out.
+func statusLevel(status int) zerolog.Level {
+	switch {
+	case status >= http.StatusInternalServerError:
+		return zerolog.ErrorLevel
+	case status >= http.StatusBadRequest:
+		return zerolog.WarnLevel
+	default:
+		return zerolog.InfoLevel
+	}
+}
+
 func LoggingMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		startTime := time.Now()
-		logger := log.With().Str("path", r.URL.Path).Logger()
+		logger := logging.Logger(logging.ComponentServer).With().Str("path", r.URL.Path).Logger()
 
 		// Attach traceId into each log within the request chain
 		traceId := r.Context().Value(tracing.TraceIdKey)
@@ -23,10 +39,17 @@ func LoggingMiddleware(next http.Handler) http.Handler {
 		logger.Debug().Msg("request received")
 		r = r.WithContext(logger.WithContext(r.Context()))
 
-		next.ServeHTTP(w, r)
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
 
 		requestDuration := time.Since(startTime).Milliseconds()
-		logEvent := logger.Info()
+		// logger from the context contains fields added by following
+		// middlewares e.g. authenticated client
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		logEvent := zerolog.Ctx(r.Context()).WithLevel(statusLevel(status)).Int("status", status)
 
 		tracingInfo := r.Context().Value(tracing.TraceInfoKey)
 		if tracingInfo != nil {
@@ -0,0 +1,284 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/signerpb"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/rs/zerolog"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	RetryAfterHeader = "Retry-After"
+
+	// limiters of clients idle for longer are forgotten
+	clientLimiterIdleTimeout = 10 * time.Minute
+
+	rejectedByRateLimit        = "rate_limit"
+	rejectedByConcurrencyLimit = "concurrency_limit"
+)
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter limits rate of requests of single client with token bucket and
+// number of requests handled at the same time by all clients. Batch requests
+// are charged by number of their items and hold as many in-flight slots as
+// items they sign concurrently.
+type RateLimiter struct {
+	limit            rate.Limit
+	burst            int
+	batchConcurrency int
+	metrics          *m.CovenantSignerMetrics
+
+	mu        sync.Mutex
+	clients   map[string]*clientLimiter
+	lastPrune time.Time
+
+	// nil if number of concurrent requests is not limited
+	inFlight chan struct{}
+}
+
+// NewRateLimiter returns rate limiter, requestsPerSecond or
+// maxConcurrentRequests equal to 0 disable respective limit. batchConcurrency
+// is max number of items of single batch request signed at the same time.
+func NewRateLimiter(
+	requestsPerSecond float64,
+	burst int,
+	maxConcurrentRequests int,
+	batchConcurrency int,
+	metrics *m.CovenantSignerMetrics,
+) *RateLimiter {
+	l := &RateLimiter{
+		limit:            rate.Limit(requestsPerSecond),
+		burst:            burst,
+		batchConcurrency: batchConcurrency,
+		metrics:          metrics,
+		clients:          make(map[string]*clientLimiter),
+	}
+
+	if maxConcurrentRequests > 0 {
+		l.inFlight = make(chan struct{}, maxConcurrentRequests)
+	}
+
+	metrics.SetRateLimits(requestsPerSecond, burst, maxConcurrentRequests)
+
+	return l
+}
+
+// allow takes n tokens from the client's bucket and returns time after which
+// the client may retry if the request is not allowed
+func (l *RateLimiter) allow(client string, n int) (time.Duration, bool) {
+	if l.limit == 0 {
+		return 0, true
+	}
+
+	now := time.Now()
+
+	l.mu.Lock()
+	if now.Sub(l.lastPrune) > clientLimiterIdleTimeout {
+		for c, cl := range l.clients {
+			if now.Sub(cl.lastSeen) > clientLimiterIdleTimeout {
+				delete(l.clients, c)
+			}
+		}
+		l.lastPrune = now
+	}
+
+	cl, ok := l.clients[client]
+	if !ok {
+		cl = &clientLimiter{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[client] = cl
+	}
+	cl.lastSeen = now
+	l.mu.Unlock()
+
+	// batch larger than burst would never fit into the bucket. It is allowed
+	// with full bucket and the remaining tokens are borrowed from the future,
+	// so that following requests of the client wait until they are refilled.
+	first := min(n, l.burst)
+	reservation := cl.limiter.ReserveN(now, first)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay, false
+	}
+
+	for borrowed := n - first; borrowed > 0; borrowed -= l.burst {
+		cl.limiter.ReserveN(now, min(borrowed, l.burst))
+	}
+
+	return 0, true
+}
+
+// acquire takes in-flight slots for request signing n items and returns
+// number of taken slots, or false if max number of requests is already being
+// handled
+func (l *RateLimiter) acquire(n int) (int, bool) {
+	if l.inFlight == nil {
+		return 0, true
+	}
+
+	// items of batch are signed at most batchConcurrency at a time, batch
+	// can take all slots, but not more
+	n = min(n, max(l.batchConcurrency, 1), cap(l.inFlight))
+	for i := 0; i < n; i++ {
+		select {
+		case l.inFlight <- struct{}{}:
+		default:
+			l.release(i)
+			return 0, false
+		}
+	}
+
+	return n, true
+}
+
+func (l *RateLimiter) release(n int) {
+	for i := 0; i < n; i++ {
+		<-l.inFlight
+	}
+}
+
+// check applies both limits to the request of the client containing n items.
+// If the request is allowed, release must be called with returned number of
+// in-flight slots once it is handled.
+func (l *RateLimiter) check(ctx context.Context, client string, n int) (inFlight int, retryAfter time.Duration, allowed bool) {
+	if retryAfter, ok := l.allow(client, n); !ok {
+		l.metrics.IncRateLimitRejections(rejectedByRateLimit)
+		zerolog.Ctx(ctx).Warn().Str("limited_client", client).Msg("request rejected by rate limit")
+		return 0, retryAfter, false
+	}
+
+	inFlight, ok := l.acquire(n)
+	if !ok {
+		l.metrics.IncRateLimitRejections(rejectedByConcurrencyLimit)
+		zerolog.Ctx(ctx).Warn().Msg("request rejected by concurrency limit")
+		// in-flight requests are expected to finish quickly
+		return 0, time.Second, false
+	}
+
+	return inFlight, 0, true
+}
+
+// rateLimitKey identifies client by authenticated client id or by the address,
+// resolved by IPFilter if it is used
+func rateLimitKey(ctx context.Context, addr string) string {
+	if clientId := ClientIdFromContext(ctx); clientId != "" {
+		return "client:" + clientId
+	}
+
+	if clientIP := ClientIPFromContext(ctx); clientIP.IsValid() {
+		return "ip:" + clientIP.String()
+	}
+
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return "ip:" + host
+	}
+
+	return "ip:" + addr
+}
+
+// retryAfterSeconds rounds delay up to whole seconds, as required by
+// Retry-After header
+func retryAfterSeconds(delay time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(delay.Seconds())))
+}
+
+// Middleware rejects requests over the limits with 429 status code and
+// Retry-After header. It must be used after AuthMiddleware, so that
+// authenticated clients are limited by their id.
+func (l *RateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l.serveHTTP(w, r, 1, next)
+	})
+}
+
+// BatchMiddleware is Middleware for batch signing requests, which are charged
+// by number of their items
+func (l *RateLimiter) BatchMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "Request Entity Too Large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		l.serveHTTP(w, r, httpBatchSize(body), next)
+	})
+}
+
+func (l *RateLimiter) serveHTTP(w http.ResponseWriter, r *http.Request, n int, next http.Handler) {
+	inFlight, retryAfter, allowed := l.check(r.Context(), rateLimitKey(r.Context(), r.RemoteAddr), n)
+	if !allowed {
+		w.Header().Set(RetryAfterHeader, retryAfterSeconds(retryAfter))
+		writeError(w, http.StatusTooManyRequests, types.TooManyRequests, "too many requests")
+		return
+	}
+	defer l.release(inFlight)
+
+	next.ServeHTTP(w, r)
+}
+
+// httpBatchSize returns number of items of batch request. Invalid request is
+// charged as single request, it is rejected by the handler.
+func httpBatchSize(body []byte) int {
+	var batch struct {
+		Requests []json.RawMessage `json:"requests"`
+	}
+	if err := json.Unmarshal(body, &batch); err != nil {
+		return 1
+	}
+
+	return max(len(batch.Requests), 1)
+}
+
+// grpcBatchSize returns number of items of batch request, or 1 for other
+// requests
+func grpcBatchSize(req any) int {
+	if batch, ok := req.(*signerpb.SignUnbondingTxsRequest); ok {
+		return max(len(batch.GetRequests()), 1)
+	}
+
+	return 1
+}
+
+// Interceptor is gRPC equivalent of Middleware and BatchMiddleware. Retry-After
+// is returned in response trailer.
+func (l *RateLimiter) Interceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	var addr string
+	if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
+
+	inFlight, retryAfter, allowed := l.check(ctx, rateLimitKey(ctx, addr), grpcBatchSize(req))
+	if !allowed {
+		_ = grpc.SetTrailer(ctx, metadata.Pairs(RetryAfterHeader, retryAfterSeconds(retryAfter)))
+		return nil, NewGrpcError(codes.ResourceExhausted, types.TooManyRequests, "too many requests")
+	}
+	defer l.release(inFlight)
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,184 @@
+package middlewares_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/middlewares"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/signerpb"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func sendFrom(handler http.Handler, remoteAddr string) *httptest.ResponseRecorder {
+	return sendBodyFrom(handler, remoteAddr, []byte(`{}`))
+}
+
+func sendBodyFrom(handler http.Handler, remoteAddr string, body []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, testPath, bytes.NewReader(body))
+	req.RemoteAddr = remoteAddr
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func requireTooManyRequests(t *testing.T, rec *httptest.ResponseRecorder) {
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	retryAfter, err := strconv.Atoi(rec.Header().Get(middlewares.RetryAfterHeader))
+	require.NoError(t, err)
+	require.Positive(t, retryAfter)
+
+	var resp struct {
+		ErrorCode string `json:"errorCode"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, types.TooManyRequests.String(), resp.ErrorCode)
+}
+
+func TestRateLimitPerClient(t *testing.T) {
+	// one token per 10s, so that the bucket is not refilled during the test
+	limiter := middlewares.NewRateLimiter(0.1, 2, 0, 1, m.NewCovenantSignerMetrics())
+	handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	require.Equal(t, http.StatusOK, sendFrom(handler, "10.0.0.1:1000").Code)
+	// the same client connecting from other port
+	require.Equal(t, http.StatusOK, sendFrom(handler, "10.0.0.1:2000").Code)
+	requireTooManyRequests(t, sendFrom(handler, "10.0.0.1:1000"))
+
+	// other clients have their own limit
+	require.Equal(t, http.StatusOK, sendFrom(handler, "10.0.0.2:1000").Code)
+}
+
+func TestConcurrencyLimit(t *testing.T) {
+	limiter := middlewares.NewRateLimiter(0, 0, 1, 1, m.NewCovenantSignerMetrics())
+
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.RemoteAddr == "10.0.0.1:1000" {
+			close(started)
+			<-unblock
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	done := make(chan int)
+	go func() {
+		done <- sendFrom(handler, "10.0.0.1:1000").Code
+	}()
+	<-started
+
+	// limit is shared by all clients
+	requireTooManyRequests(t, sendFrom(handler, "10.0.0.2:1000"))
+
+	close(unblock)
+	require.Equal(t, http.StatusOK, <-done)
+	require.Equal(t, http.StatusOK, sendFrom(handler, "10.0.0.2:1000").Code)
+}
+
+func batchBody(t *testing.T, items int) []byte {
+	body, err := json.Marshal(&types.SignUnbondingTxsRequest{
+		Requests: make([]types.SignUnbondingTxRequest, items),
+	})
+	require.NoError(t, err)
+	return body
+}
+
+func TestRateLimitChargesBatchItems(t *testing.T) {
+	// one token per 10s, so that the bucket is not refilled during the test
+	limiter := middlewares.NewRateLimiter(0.1, 4, 0, 1, m.NewCovenantSignerMetrics())
+	handler := limiter.BatchMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// body is still readable by the handler
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, batchBody(t, 3), body)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	require.Equal(t, http.StatusOK, sendBodyFrom(handler, "10.0.0.1:1000", batchBody(t, 3)).Code)
+	// only one token is left
+	requireTooManyRequests(t, sendBodyFrom(handler, "10.0.0.1:1000", batchBody(t, 3)))
+
+	// batch larger than burst is allowed with full bucket, but the client has
+	// to wait until the missing tokens are refilled
+	handler = limiter.BatchMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	require.Equal(t, http.StatusOK, sendBodyFrom(handler, "10.0.0.2:1000", batchBody(t, 10)).Code)
+	rec := sendFrom(limiter.Middleware(handler), "10.0.0.2:1000")
+	requireTooManyRequests(t, rec)
+	retryAfter, err := strconv.Atoi(rec.Header().Get(middlewares.RetryAfterHeader))
+	require.NoError(t, err)
+	// 6 borrowed tokens and one for the request, 10s each
+	require.GreaterOrEqual(t, retryAfter, 69)
+}
+
+func TestConcurrencyLimitCountsBatchConcurrency(t *testing.T) {
+	// batch of 5 items signs at most 2 items at a time
+	limiter := middlewares.NewRateLimiter(0, 0, 3, 2, m.NewCovenantSignerMetrics())
+
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.RemoteAddr == "10.0.0.1:1000" {
+			close(started)
+			<-unblock
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	batchHandler := limiter.BatchMiddleware(handler)
+
+	done := make(chan int)
+	go func() {
+		done <- sendBodyFrom(batchHandler, "10.0.0.1:1000", batchBody(t, 5)).Code
+	}()
+	<-started
+
+	// batch holds 2 of 3 slots, so other batch does not fit, but single
+	// request does
+	requireTooManyRequests(t, sendBodyFrom(batchHandler, "10.0.0.2:1000", batchBody(t, 5)))
+	require.Equal(t, http.StatusOK, sendFrom(limiter.Middleware(handler), "10.0.0.2:1000").Code)
+
+	close(unblock)
+	require.Equal(t, http.StatusOK, <-done)
+	require.Equal(t, http.StatusOK, sendBodyFrom(batchHandler, "10.0.0.2:1000", batchBody(t, 5)).Code)
+}
+
+func TestRateLimitInterceptorChargesBatchItems(t *testing.T) {
+	limiter := middlewares.NewRateLimiter(0.1, 4, 0, 1, m.NewCovenantSignerMetrics())
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1000},
+	})
+	handler := func(context.Context, any) (any, error) {
+		return nil, nil
+	}
+	batch := &signerpb.SignUnbondingTxsRequest{
+		Requests: make([]*signerpb.SignUnbondingTxRequest, 3),
+	}
+
+	_, err := limiter.Interceptor(ctx, batch, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+
+	// only one token is left
+	_, err = limiter.Interceptor(ctx, batch, &grpc.UnaryServerInfo{}, handler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = limiter.Interceptor(ctx, &signerpb.SignUnbondingTxRequest{}, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+}
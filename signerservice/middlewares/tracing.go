@@ -1,14 +1,42 @@
 package middlewares
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/babylonlabs-io/covenant-signer/observability/tracing"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
 )
 
+// TracingMiddleware starts span of the request, continuing the trace of the
+// client if the request has W3C traceparent header
 func TracingMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := tracing.AttachTracingIntoContext(r.Context())
-		next.ServeHTTP(w, r.WithContext(ctx))
+		ctx := tracing.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracing.StartRequest(
+			ctx,
+			r.Method+" "+r.URL.Path,
+			attribute.String("http.request.method", r.Method),
+			attribute.String("url.path", r.URL.Path),
+		)
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r.WithContext(ctx))
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+
+		// only server errors mark the span as failed, rejected requests are
+		// expected
+		var err error
+		if status >= http.StatusInternalServerError {
+			err = fmt.Errorf("request failed with status %d", status)
+		}
+		span.End(err)
 	})
 }
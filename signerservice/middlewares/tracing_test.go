@@ -0,0 +1,133 @@
+package middlewares_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/babylonlabs-io/covenant-signer/observability/tracing"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/middlewares"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	clientTraceId = "4bf92f3577b34da6a3ce929d0e0e4736"
+	clientSpanId  = "00f067aa0ba902b7"
+	traceparent   = "00-" + clientTraceId + "-" + clientSpanId + "-01"
+)
+
+// setupTestExporter records spans of all tracers in memory until the test
+// finishes
+func setupTestExporter(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		_ = provider.Shutdown(context.Background())
+	})
+
+	return exporter
+}
+
+// requireChildOfClientSpan checks that the only exported span is server span
+// continuing the trace of the client
+func requireChildOfClientSpan(t *testing.T, exporter *tracetest.InMemoryExporter, name string) {
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	require.Equal(t, name, spans[0].Name)
+	require.Equal(t, trace.SpanKindServer, spans[0].SpanKind)
+	require.Equal(t, clientTraceId, spans[0].SpanContext.TraceID().String())
+	require.Equal(t, clientSpanId, spans[0].Parent.SpanID().String())
+	require.True(t, spans[0].Parent.IsRemote())
+}
+
+func TestTracingMiddlewareContinuesClientTrace(t *testing.T) {
+	exporter := setupTestExporter(t)
+
+	var traceId any
+	handler := middlewares.TracingMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceId = r.Context().Value(tracing.TraceIdKey)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/sign-unbonding-tx", nil)
+	req.Header.Set("traceparent", traceparent)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Equal(t, clientTraceId, traceId)
+	requireChildOfClientSpan(t, exporter, "POST /v1/sign-unbonding-tx")
+}
+
+func TestTracingMiddlewareStartsNewTrace(t *testing.T) {
+	exporter := setupTestExporter(t)
+
+	var traceId any
+	handler := middlewares.TracingMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceId = r.Context().Value(tracing.TraceIdKey)
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/v1/sign-unbonding-tx", nil))
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	require.False(t, spans[0].Parent.IsValid())
+	require.Equal(t, spans[0].SpanContext.TraceID().String(), traceId)
+}
+
+func TestTracingInterceptorContinuesClientTrace(t *testing.T) {
+	exporter := setupTestExporter(t)
+
+	const method = "/covenantsigner.v1.CovenantSigner/SignUnbondingTx"
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("traceparent", traceparent))
+
+	var traceId any
+	_, err := middlewares.TracingInterceptor(
+		ctx,
+		nil,
+		&grpc.UnaryServerInfo{FullMethod: method},
+		func(ctx context.Context, _ any) (any, error) {
+			traceId = ctx.Value(tracing.TraceIdKey)
+			return nil, nil
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, clientTraceId, traceId)
+	requireChildOfClientSpan(t, exporter, method)
+}
+
+func TestTracingClientInterceptorInjectsTraceparent(t *testing.T) {
+	setupTestExporter(t)
+
+	ctx, span := tracing.StartSpan(context.Background(), "client")
+	defer span.End(nil)
+
+	var md metadata.MD
+	err := middlewares.TracingClientInterceptor(
+		ctx,
+		"/covenantsigner.v1.CovenantSigner/SignUnbondingTx",
+		nil,
+		nil,
+		nil,
+		func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			md, _ = metadata.FromOutgoingContext(ctx)
+			return nil
+		},
+	)
+	require.NoError(t, err)
+
+	spanContext := trace.SpanContextFromContext(ctx)
+	require.Equal(t, []string{
+		"00-" + spanContext.TraceID().String() + "-" + spanContext.SpanID().String() + "-01",
+	}, md.Get("traceparent"))
+}
@@ -0,0 +1,22 @@
+package signerservice
+
+import (
+	_ "embed"
+	"net/http"
+
+	logger "github.com/rs/zerolog"
+)
+
+// OpenAPISpec is OpenAPI 3 document describing all routes of the signing
+// server. It must be updated whenever routes or request/response types change.
+//
+//go:embed openapi.json
+var OpenAPISpec []byte
+
+func serveOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(OpenAPISpec); err != nil {
+		logger.Ctx(r.Context()).Err(err).Msg("failed to write response")
+	}
+}
@@ -0,0 +1,261 @@
+package signerservice_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/babylonlabs-io/covenant-signer/config"
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/signerservice"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type routeTypes struct {
+	request  any
+	response any
+}
+
+// every route must be listed here with its request and response types
+var routes = map[string]routeTypes{
+	"POST /v1/sign-unbonding-tx":            {types.SignUnbondingTxRequest{}, types.SignUnbondingTxResponse{}},
+	"POST /v1/sign-unbonding-txs":           {types.SignUnbondingTxsRequest{}, types.SignUnbondingTxsResponse{}},
+	"POST /v1/sign-slashing-tx":             {types.SignSlashingTxRequest{}, types.SignSlashingTxResponse{}},
+	"POST /v1/sign-unbonding-slashing-tx":   {types.SignUnbondingSlashingTxRequest{}, types.SignUnbondingSlashingTxResponse{}},
+	"POST /v1/sign-unbonding-by-staking-tx": {types.SignUnbondingByStakingTxRequest{}, types.SignUnbondingByStakingTxResponse{}},
+	"POST /v1/sign-unbonding-psbt":          {types.SignUnbondingPsbtRequest{}, types.SignUnbondingPsbtResponse{}},
+	"POST /v1/validate-unbonding-tx":        {types.SignUnbondingTxRequest{}, types.ValidateUnbondingTxResponse{}},
+	"GET /v1/openapi.json":                  {},
+}
+
+type openAPISpec struct {
+	Paths      map[string]map[string]*operation `json:"paths"`
+	Components struct {
+		Schemas map[string]*schema `json:"schemas"`
+	} `json:"components"`
+}
+
+type operation struct {
+	RequestBody *struct {
+		Content map[string]struct {
+			Schema *schema `json:"schema"`
+		} `json:"content"`
+	} `json:"requestBody"`
+	Responses map[string]*struct {
+		Content map[string]struct {
+			Schema *schema `json:"schema"`
+		} `json:"content"`
+	} `json:"responses"`
+}
+
+type schema struct {
+	Ref        string             `json:"$ref"`
+	Type       string             `json:"type"`
+	Properties map[string]*schema `json:"properties"`
+	Required   []string           `json:"required"`
+	Items      *schema            `json:"items"`
+	AllOf      []*schema          `json:"allOf"`
+	Enum       []string           `json:"enum"`
+}
+
+func loadSpec(t *testing.T) *openAPISpec {
+	var spec openAPISpec
+	require.NoError(t, json.Unmarshal(signerservice.OpenAPISpec, &spec))
+	return &spec
+}
+
+// resolve follows references and merges allOf schemas
+func (s *openAPISpec) resolve(t *testing.T, sc *schema) *schema {
+	if sc.Ref != "" {
+		name := strings.TrimPrefix(sc.Ref, "#/components/schemas/")
+		resolved, ok := s.Components.Schemas[name]
+		require.True(t, ok, "unknown schema %s", sc.Ref)
+		return s.resolve(t, resolved)
+	}
+
+	if len(sc.AllOf) == 0 {
+		return sc
+	}
+
+	merged := &schema{Type: "object", Properties: map[string]*schema{}}
+	for _, part := range sc.AllOf {
+		resolved := s.resolve(t, part)
+		for name, prop := range resolved.Properties {
+			merged.Properties[name] = prop
+		}
+		merged.Required = append(merged.Required, resolved.Required...)
+	}
+	return merged
+}
+
+// jsonFields returns json fields of the struct type, including fields of
+// embedded structs, and names of fields which are always serialized
+func jsonFields(typ reflect.Type) (map[string]reflect.Type, []string) {
+	fields := map[string]reflect.Type{}
+	required := []string{}
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if f.Anonymous {
+			embedded, embeddedRequired := jsonFields(f.Type)
+			for name, ft := range embedded {
+				fields[name] = ft
+			}
+			required = append(required, embeddedRequired...)
+			continue
+		}
+
+		tag := strings.Split(f.Tag.Get("json"), ",")
+		fields[tag[0]] = f.Type
+		if len(tag) == 1 {
+			required = append(required, tag[0])
+		}
+	}
+	return fields, required
+}
+
+func structType(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return nil
+	}
+	return typ
+}
+
+func requireSchemaMatchesType(t *testing.T, spec *openAPISpec, sc *schema, typ reflect.Type, path string) {
+	resolved := spec.resolve(t, sc)
+	if resolved.Type == "array" {
+		require.NotNil(t, resolved.Items, "%s: array without items", path)
+		resolved = spec.resolve(t, resolved.Items)
+	}
+
+	fields, required := jsonFields(typ)
+
+	specFields := make([]string, 0, len(resolved.Properties))
+	for name := range resolved.Properties {
+		specFields = append(specFields, name)
+	}
+	typeFields := make([]string, 0, len(fields))
+	for name := range fields {
+		typeFields = append(typeFields, name)
+	}
+	sort.Strings(specFields)
+	sort.Strings(typeFields)
+	sort.Strings(required)
+	specRequired := append([]string{}, resolved.Required...)
+	sort.Strings(specRequired)
+
+	require.Equal(t, typeFields, specFields, "%s: fields of %s do not match spec", path, typ.Name())
+	require.Equal(t, required, specRequired, "%s: required fields of %s do not match spec", path, typ.Name())
+
+	for name, ft := range fields {
+		if nested := structType(ft); nested != nil {
+			requireSchemaMatchesType(t, spec, resolved.Properties[name], nested, path+"."+name)
+		}
+	}
+}
+
+func newTestConfig() *config.ParsedConfig {
+	return &config.ParsedConfig{
+		ServerConfig: &config.ParsedServerConfig{
+			Host:             "127.0.0.1",
+			Port:             0,
+			MaxContentLength: 8192,
+		},
+		SignerAppConfig: &config.ParsedSignerAppConfig{
+			MaxBatchSize:     10,
+			BatchConcurrency: 1,
+		},
+	}
+}
+
+func newTestServer(t *testing.T) *signerservice.SigningServer {
+	server, err := signerservice.New(context.Background(), newTestConfig(), nil, m.NewCovenantSignerMetrics())
+	require.NoError(t, err)
+	return server
+}
+
+func TestOpenAPISpecMatchesRoutes(t *testing.T) {
+	spec := loadSpec(t)
+	server := newTestServer(t)
+
+	registered := map[string]bool{}
+	err := chi.Walk(server.Handler().(chi.Routes), func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		registered[method+" "+route] = true
+		return nil
+	})
+	require.NoError(t, err)
+
+	documented := map[string]bool{}
+	for path, ops := range spec.Paths {
+		for method := range ops {
+			documented[strings.ToUpper(method)+" "+path] = true
+		}
+	}
+
+	listed := map[string]bool{}
+	for route := range routes {
+		listed[route] = true
+	}
+
+	require.Equal(t, registered, documented, "registered routes do not match spec")
+	require.Equal(t, registered, listed, "registered routes do not match routes listed in test")
+}
+
+func TestOpenAPISpecMatchesTypes(t *testing.T) {
+	spec := loadSpec(t)
+
+	for route, rt := range routes {
+		if rt.request == nil {
+			continue
+		}
+
+		parts := strings.SplitN(route, " ", 2)
+		op := spec.Paths[parts[1]][strings.ToLower(parts[0])]
+		require.NotNil(t, op, route)
+
+		require.NotNil(t, op.RequestBody, route)
+		requireSchemaMatchesType(
+			t, spec,
+			op.RequestBody.Content["application/json"].Schema,
+			reflect.TypeOf(rt.request),
+			route+" request",
+		)
+
+		ok := op.Responses["200"]
+		require.NotNil(t, ok, route)
+		data := ok.Content["application/json"].Schema.Properties["data"]
+		require.NotNil(t, data, route)
+		requireSchemaMatchesType(t, spec, data, reflect.TypeOf(rt.response), route+" response")
+	}
+}
+
+func TestOpenAPISpecMatchesErrorCodes(t *testing.T) {
+	spec := loadSpec(t)
+
+	codes := make([]string, 0)
+	for _, c := range types.ErrorCodes() {
+		codes = append(codes, c.String())
+	}
+
+	require.ElementsMatch(t, codes, spec.Components.Schemas["ErrorCode"].Enum)
+}
+
+func TestServeOpenAPISpec(t *testing.T) {
+	server := newTestServer(t)
+
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/openapi.json", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	require.JSONEq(t, string(signerservice.OpenAPISpec), rec.Body.String())
+}
@@ -0,0 +1,177 @@
+package signerservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/babylonlabs-io/babylon/btcstaking"
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// CovenantMember is covenant committee member and url of its signer
+type CovenantMember struct {
+	PublicKey *btcec.PublicKey
+	SignerUrl string
+}
+
+// CovenantSignature is signature of single covenant committee member
+type CovenantSignature struct {
+	PublicKey *btcec.PublicKey
+	Signature *schnorr.Signature
+}
+
+// QuorumUnbondingRequest is request for covenant signatures of unbonding
+// transaction sent to all covenant committee members
+type QuorumUnbondingRequest struct {
+	// StakingOutput is the output spent by the unbonding transaction
+	StakingOutput *wire.TxOut
+	// UnbondingPathScript is script of the unbonding path of the staking
+	// output, used to verify received signatures
+	UnbondingPathScript []byte
+	UnbondingTx         *wire.MsgTx
+	StakerUnbondingSig  *schnorr.Signature
+	// Phase2Data must be provided for phase-2 staking transactions and can be
+	// nil otherwise
+	Phase2Data *signerapp.Phase2StakingData
+}
+
+type memberClient struct {
+	member *CovenantMember
+	client *Client
+}
+
+// QuorumClient sends requests to signers of all covenant committee members
+// concurrently and returns once quorum of valid signatures is collected.
+// It is safe for concurrent use.
+type QuorumClient struct {
+	members []*memberClient
+	quorum  uint32
+}
+
+func NewQuorumClient(
+	members []*CovenantMember,
+	quorum uint32,
+	cfg *ClientConfig,
+) (*QuorumClient, error) {
+	if quorum == 0 {
+		return nil, fmt.Errorf("covenant quorum must be greater than 0")
+	}
+
+	if int(quorum) > len(members) {
+		return nil, fmt.Errorf("covenant quorum %d is greater than number of covenant members %d", quorum, len(members))
+	}
+
+	seen := make(map[string]struct{}, len(members))
+	clients := make([]*memberClient, 0, len(members))
+	for _, m := range members {
+		key := string(schnorr.SerializePubKey(m.PublicKey))
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("duplicated covenant member key %x", key)
+		}
+		seen[key] = struct{}{}
+
+		clients = append(clients, &memberClient{
+			member: m,
+			client: NewClient(m.SignerUrl, cfg),
+		})
+	}
+
+	return &QuorumClient{
+		members: clients,
+		quorum:  quorum,
+	}, nil
+}
+
+type memberResult struct {
+	member *CovenantMember
+	sig    *schnorr.Signature
+	err    error
+}
+
+// SignUnbonding requests covenant signatures of unbonding transaction from all
+// covenant members. Each signature is verified against the staking output
+// before it is counted. Once quorum of valid signatures is collected, requests
+// still in flight are cancelled and collected signatures are returned. Error
+// is returned if quorum can no longer be reached.
+func (q *QuorumClient) SignUnbonding(
+	ctx context.Context,
+	request *QuorumUnbondingRequest,
+) ([]*CovenantSignature, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// buffered so that goroutines of cancelled requests do not block
+	results := make(chan *memberResult, len(q.members))
+	for _, m := range q.members {
+		go func(m *memberClient) {
+			sig, err := m.client.SignUnbonding(ctx, &signerapp.UnbondingSigningRequest{
+				StakingOutputPkScript: request.StakingOutput.PkScript,
+				UnbondingTx:           request.UnbondingTx,
+				StakerUnbondingSig:    request.StakerUnbondingSig,
+				CovenantPublicKey:     m.member.PublicKey,
+				Phase2Data:            request.Phase2Data,
+			})
+
+			if err == nil {
+				err = verifyCovenantSignature(request, m.member.PublicKey, sig)
+			}
+
+			results <- &memberResult{member: m.member, sig: sig, err: err}
+		}(m)
+	}
+
+	maxFailures := len(q.members) - int(q.quorum)
+	var (
+		sigs     []*CovenantSignature
+		failures []error
+	)
+	for range q.members {
+		res := <-results
+
+		if res.err != nil {
+			failures = append(failures, fmt.Errorf("covenant member %x: %w", schnorr.SerializePubKey(res.member.PublicKey), res.err))
+
+			if len(failures) > maxFailures {
+				return nil, fmt.Errorf("failed to collect quorum of %d covenant signatures: %w", q.quorum, errors.Join(failures...))
+			}
+
+			continue
+		}
+
+		sigs = append(sigs, &CovenantSignature{
+			PublicKey: res.member.PublicKey,
+			Signature: res.sig,
+		})
+
+		if len(sigs) == int(q.quorum) {
+			return sigs, nil
+		}
+	}
+
+	// unreachable, either quorum is collected or too many requests failed
+	return nil, fmt.Errorf("failed to collect quorum of %d covenant signatures", q.quorum)
+}
+
+func verifyCovenantSignature(
+	request *QuorumUnbondingRequest,
+	covenantKey *btcec.PublicKey,
+	sig *schnorr.Signature,
+) error {
+	err := btcstaking.VerifyTransactionSigWithOutput(
+		request.UnbondingTx,
+		request.StakingOutput,
+		request.UnbondingPathScript,
+		covenantKey,
+		sig.Serialize(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("invalid covenant signature: %w", err)
+	}
+
+	return nil
+}
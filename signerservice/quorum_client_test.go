@@ -0,0 +1,229 @@
+package signerservice_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/babylonlabs-io/babylon/btcstaking"
+	"github.com/babylonlabs-io/covenant-signer/signerservice"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/handlers"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+type quorumTestData struct {
+	covenantKeys []*btcec.PrivateKey
+	request      *signerservice.QuorumUnbondingRequest
+}
+
+func newQuorumTestData(t *testing.T, numMembers int, quorum uint32) *quorumTestData {
+	stakerKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	fpKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	covenantKeys := make([]*btcec.PrivateKey, 0, numMembers)
+	covenantPubKeys := make([]*btcec.PublicKey, 0, numMembers)
+	for i := 0; i < numMembers; i++ {
+		key, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+		covenantKeys = append(covenantKeys, key)
+		covenantPubKeys = append(covenantPubKeys, key.PubKey())
+	}
+
+	stakingInfo, err := btcstaking.BuildStakingInfo(
+		stakerKey.PubKey(),
+		[]*btcec.PublicKey{fpKey.PubKey()},
+		covenantPubKeys,
+		quorum,
+		1000,
+		btcutil.Amount(100000),
+		&chaincfg.RegressionNetParams,
+	)
+	require.NoError(t, err)
+
+	unbondingPathInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	require.NoError(t, err)
+
+	unbondingTx := wire.NewMsgTx(2)
+	unbondingTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, nil))
+	unbondingTx.AddTxOut(wire.NewTxOut(90000, stakingInfo.StakingOutput.PkScript))
+
+	stakerSig, err := btcstaking.SignTxWithOneScriptSpendInputFromScript(
+		unbondingTx,
+		stakingInfo.StakingOutput,
+		stakerKey,
+		unbondingPathInfo.RevealedLeaf.Script,
+	)
+	require.NoError(t, err)
+
+	return &quorumTestData{
+		covenantKeys: covenantKeys,
+		request: &signerservice.QuorumUnbondingRequest{
+			StakingOutput:       stakingInfo.StakingOutput,
+			UnbondingPathScript: unbondingPathInfo.RevealedLeaf.Script,
+			UnbondingTx:         unbondingTx,
+			StakerUnbondingSig:  stakerSig,
+		},
+	}
+}
+
+func (d *quorumTestData) sign(t *testing.T, key *btcec.PrivateKey) *schnorr.Signature {
+	sig, err := btcstaking.SignTxWithOneScriptSpendInputFromScript(
+		d.request.UnbondingTx,
+		d.request.StakingOutput,
+		key,
+		d.request.UnbondingPathScript,
+	)
+	require.NoError(t, err)
+	return sig
+}
+
+func newSignatureServer(t *testing.T, sig *schnorr.Signature) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, handlers.PublicResponse[types.SignUnbondingTxResponse]{
+			Data: types.SignUnbondingTxResponse{SignatureHex: hexSig(sig)},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newErrorServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusInternalServerError, &signerservice.ErrorResponse{
+			ErrorCode: types.InternalServiceError.String(),
+			Message:   "signer unavailable",
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestQuorumClientSkipsInvalidSignatures(t *testing.T) {
+	data := newQuorumTestData(t, 3, 2)
+
+	wrongKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	members := []*signerservice.CovenantMember{
+		{
+			PublicKey: data.covenantKeys[0].PubKey(),
+			SignerUrl: newSignatureServer(t, data.sign(t, data.covenantKeys[0])).URL,
+		},
+		{
+			// signer returns signature made with the key of other member
+			PublicKey: data.covenantKeys[1].PubKey(),
+			SignerUrl: newSignatureServer(t, data.sign(t, wrongKey)).URL,
+		},
+		{
+			PublicKey: data.covenantKeys[2].PubKey(),
+			SignerUrl: newSignatureServer(t, data.sign(t, data.covenantKeys[2])).URL,
+		},
+	}
+
+	client, err := signerservice.NewQuorumClient(members, 2, testClientConfig())
+	require.NoError(t, err)
+
+	sigs, err := client.SignUnbonding(context.Background(), data.request)
+	require.NoError(t, err)
+	require.Len(t, sigs, 2)
+
+	for _, sig := range sigs {
+		require.False(t, sig.PublicKey.IsEqual(data.covenantKeys[1].PubKey()))
+		require.NoError(t, btcstaking.VerifyTransactionSigWithOutput(
+			data.request.UnbondingTx,
+			data.request.StakingOutput,
+			data.request.UnbondingPathScript,
+			sig.PublicKey,
+			sig.Signature.Serialize(),
+		))
+	}
+}
+
+func TestQuorumClientReturnsBeforeSlowSigners(t *testing.T) {
+	data := newQuorumTestData(t, 3, 2)
+
+	blocked := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-blocked:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(slow.Close)
+	t.Cleanup(func() { close(blocked) })
+
+	members := []*signerservice.CovenantMember{
+		{
+			PublicKey: data.covenantKeys[0].PubKey(),
+			SignerUrl: newSignatureServer(t, data.sign(t, data.covenantKeys[0])).URL,
+		},
+		{
+			PublicKey: data.covenantKeys[1].PubKey(),
+			SignerUrl: slow.URL,
+		},
+		{
+			PublicKey: data.covenantKeys[2].PubKey(),
+			SignerUrl: newSignatureServer(t, data.sign(t, data.covenantKeys[2])).URL,
+		},
+	}
+
+	cfg := testClientConfig()
+	cfg.Timeout = time.Minute
+	client, err := signerservice.NewQuorumClient(members, 2, cfg)
+	require.NoError(t, err)
+
+	sigs, err := client.SignUnbonding(context.Background(), data.request)
+	require.NoError(t, err)
+	require.Len(t, sigs, 2)
+}
+
+func TestQuorumClientFailsWhenQuorumUnreachable(t *testing.T) {
+	data := newQuorumTestData(t, 3, 2)
+
+	members := []*signerservice.CovenantMember{
+		{
+			PublicKey: data.covenantKeys[0].PubKey(),
+			SignerUrl: newSignatureServer(t, data.sign(t, data.covenantKeys[0])).URL,
+		},
+		{
+			PublicKey: data.covenantKeys[1].PubKey(),
+			SignerUrl: newErrorServer(t).URL,
+		},
+		{
+			PublicKey: data.covenantKeys[2].PubKey(),
+			SignerUrl: newErrorServer(t).URL,
+		},
+	}
+
+	client, err := signerservice.NewQuorumClient(members, 2, testClientConfig())
+	require.NoError(t, err)
+
+	_, err = client.SignUnbonding(context.Background(), data.request)
+	require.ErrorContains(t, err, "failed to collect quorum")
+}
+
+func TestNewQuorumClientValidatesQuorum(t *testing.T) {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	members := []*signerservice.CovenantMember{
+		{PublicKey: key.PubKey(), SignerUrl: "http://localhost:1"},
+	}
+
+	_, err = signerservice.NewQuorumClient(members, 2, testClientConfig())
+	require.Error(t, err)
+
+	_, err = signerservice.NewQuorumClient(append(members, members[0]), 1, testClientConfig())
+	require.Error(t, err)
+}
@@ -4,11 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
 	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
 	"github.com/babylonlabs-io/covenant-signer/signerservice/handlers"
 	"github.com/babylonlabs-io/covenant-signer/signerservice/middlewares"
-	"github.com/rs/zerolog/log"
 
 	"github.com/babylonlabs-io/covenant-signer/config"
 	s "github.com/babylonlabs-io/covenant-signer/signerapp"
@@ -18,11 +19,99 @@ import (
 type SigningServer struct {
 	httpServer *http.Server
 	handler    *handlers.Handler
+	metrics    *m.CovenantSignerMetrics
+	// nil if requests are not authenticated
+	authenticators []middlewares.Authenticator
+	// nil if requests are not limited
+	rateLimiter *middlewares.RateLimiter
+	// nil if addresses are not filtered
+	ipFilter *middlewares.IPFilter
+
+	maxContentLength      int64
+	maxBatchContentLength int64
 }
 
 func (a *SigningServer) SetupRoutes(r *chi.Mux) {
 	handler := a.handler
-	r.Post("/v1/sign-unbonding-tx", registerHandler(handler.SignUnbonding))
+	r.Get("/v1/openapi.json", serveOpenAPISpec)
+	r.Group(func(r chi.Router) {
+		r.Use(middlewares.ContentLengthMiddleware(a.maxContentLength))
+		a.useAuth(r)
+		a.useRateLimit(r)
+		r.Post("/v1/sign-unbonding-tx", registerHandler(handlers.JSONHandler(handler.SignUnbonding)))
+		r.Post("/v1/sign-slashing-tx", registerHandler(handlers.JSONHandler(handler.SignSlashing)))
+		r.Post("/v1/sign-unbonding-slashing-tx", registerHandler(handlers.JSONHandler(handler.SignUnbondingSlashing)))
+		r.Post("/v1/sign-unbonding-by-staking-tx", registerHandler(handlers.JSONHandler(handler.SignUnbondingByStakingTx)))
+		r.Post("/v1/sign-unbonding-psbt", registerHandler(handlers.JSONHandler(handler.SignUnbondingPsbt)))
+		r.Post("/v1/validate-unbonding-tx", registerHandler(handlers.JSONHandler(handler.ValidateUnbonding)))
+	})
+	// batch request contains up to max batch size single requests
+	r.Group(func(r chi.Router) {
+		r.Use(middlewares.ContentLengthMiddleware(a.maxBatchContentLength))
+		a.useAuth(r)
+		a.useBatchRateLimit(r)
+		r.Post("/v1/sign-unbonding-txs", registerHandler(handlers.JSONHandler(handler.SignUnbondingBatch)))
+	})
+}
+
+func (a *SigningServer) useAuth(r chi.Router) {
+	if len(a.authenticators) > 0 {
+		r.Use(middlewares.AuthMiddleware(a.authenticators, a.metrics))
+	}
+}
+
+func (a *SigningServer) useRateLimit(r chi.Router) {
+	if a.rateLimiter != nil {
+		r.Use(a.rateLimiter.Middleware)
+	}
+}
+
+// useBatchRateLimit charges batch requests by number of their items
+func (a *SigningServer) useBatchRateLimit(r chi.Router) {
+	if a.rateLimiter != nil {
+		r.Use(a.rateLimiter.BatchMiddleware)
+	}
+}
+
+// newAuthenticators returns nil if no client keys are configured
+func newAuthenticators(cfg *config.ParsedAuthConfig) []middlewares.Authenticator {
+	if cfg == nil || !cfg.Enabled() {
+		return nil
+	}
+
+	return []middlewares.Authenticator{
+		middlewares.NewApiKeyAuthenticator(cfg.ApiKeyHashes),
+		middlewares.NewSignatureAuthenticator(cfg.HmacKeys, cfg.Ed25519Keys, cfg.MaxClockSkew, time.Now),
+	}
+}
+
+// newIPFilter returns nil if neither allowed addresses nor trusted proxies are
+// configured
+func newIPFilter(cfg *config.ParsedServerConfig) *middlewares.IPFilter {
+	if len(cfg.AllowedCidrs) == 0 && len(cfg.TrustedProxies) == 0 {
+		return nil
+	}
+
+	return middlewares.NewIPFilter(cfg.AllowedCidrs, cfg.TrustedProxies)
+}
+
+// newRateLimiter returns nil if rate limits are not configured
+func newRateLimiter(
+	cfg *config.ParsedRateLimitConfig,
+	batchConcurrency int,
+	metrics *m.CovenantSignerMetrics,
+) *middlewares.RateLimiter {
+	if cfg == nil {
+		return nil
+	}
+
+	return middlewares.NewRateLimiter(
+		cfg.RequestsPerSecond,
+		cfg.Burst,
+		cfg.MaxConcurrentRequests,
+		batchConcurrency,
+		metrics,
+	)
 }
 
 func New(
@@ -37,7 +126,11 @@ func New(
 	// r.Use(middlewares.CorsMiddleware(cfg))
 	r.Use(middlewares.TracingMiddleware)
 	r.Use(middlewares.LoggingMiddleware)
-	r.Use(middlewares.ContentLengthMiddleware(int64(cfg.ServerConfig.MaxContentLength)))
+
+	ipFilter := newIPFilter(cfg.ServerConfig)
+	if ipFilter != nil {
+		r.Use(ipFilter.Middleware)
+	}
 	// TODO: TLS configuration if server is to be exposed over the internet, if it supposed to
 	// be behind some reverse proxy like nginx or cloudflare, then it's not needed.
 	// Probably it needs to be configurable
@@ -52,19 +145,31 @@ func New(
 
 	h, err := handlers.NewHandler(ctx, signer, metrics)
 	if err != nil {
-		log.Fatal().Err(err).Msg("error while setting up handlers")
+		logging.Logger(logging.ComponentServer).Fatal().Err(err).Msg("error while setting up handlers")
 	}
 
+	maxContentLength := int64(cfg.ServerConfig.MaxContentLength)
 	server := &SigningServer{
-		httpServer: srv,
-		handler:    h,
+		httpServer:            srv,
+		handler:               h,
+		metrics:               metrics,
+		authenticators:        newAuthenticators(cfg.AuthConfig),
+		rateLimiter:           newRateLimiter(cfg.RateLimitConfig, cfg.SignerAppConfig.BatchConcurrency, metrics),
+		ipFilter:              ipFilter,
+		maxContentLength:      maxContentLength,
+		maxBatchContentLength: maxContentLength * int64(cfg.SignerAppConfig.MaxBatchSize),
 	}
 	server.SetupRoutes(r)
 	return server, nil
 }
 
+// Handler returns http handler serving all routes of the server
+func (s *SigningServer) Handler() http.Handler {
+	return s.httpServer.Handler
+}
+
 func (s *SigningServer) Start() error {
-	log.Info().Msgf("Starting server on %s", s.httpServer.Addr)
+	logging.Logger(logging.ComponentServer).Info().Msgf("Starting server on %s", s.httpServer.Addr)
 	return s.httpServer.ListenAndServe()
 }
 
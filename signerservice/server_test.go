@@ -0,0 +1,132 @@
+package signerservice_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/babylonlabs-io/covenant-signer/config"
+	"github.com/babylonlabs-io/covenant-signer/observability/logging"
+	m "github.com/babylonlabs-io/covenant-signer/observability/metrics"
+	"github.com/babylonlabs-io/covenant-signer/signerapp"
+	"github.com/babylonlabs-io/covenant-signer/signerservice"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/middlewares"
+	"github.com/babylonlabs-io/covenant-signer/signerservice/types"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRejectedRequestOfUnknownKeyIsNotLabeledByKey(t *testing.T) {
+	cfg := newTestConfig()
+	app := signerapp.NewSignerApp(nil, nil, nil, &config.ParsedSignerAppConfig{}, &chaincfg.RegressionNetParams)
+	metrics := m.NewCovenantSignerMetrics()
+	server, err := signerservice.New(context.Background(), cfg, app, metrics)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(server.Handler())
+	defer srv.Close()
+
+	// request with random key is rejected before the key is checked
+	req, _ := newTestSigningRequest(t)
+	_, err = signerservice.NewClient(srv.URL, &signerservice.ClientConfig{}).SignUnbonding(context.Background(), req)
+	var signerErr *signerservice.SignerError
+	require.True(t, errors.As(err, &signerErr))
+	require.Equal(t, types.BadRequest, signerErr.ErrorCode)
+
+	require.Equal(t, 1, testutil.CollectAndCount(metrics.RejectedSigningRequests))
+	require.Equal(t, float64(1), testutil.ToFloat64(
+		metrics.RejectedSigningRequests.WithLabelValues(signerapp.CheckStakingOutputPkScript, "unknown"),
+	))
+}
+
+// initTestLogs writes logs of all components to a file until the test ends
+func initTestLogs(t *testing.T) string {
+	globalLogger := log.Logger
+
+	cfg := config.DefaultLoggingConfig()
+	cfg.File = filepath.Join(t.TempDir(), "signer.log")
+	parsed, err := cfg.Parse()
+	require.NoError(t, err)
+
+	closeLogs, err := logging.Init(parsed)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, closeLogs())
+
+		// restore logging to stderr
+		parsed, err := config.DefaultLoggingConfig().Parse()
+		require.NoError(t, err)
+		_, err = logging.Init(parsed)
+		require.NoError(t, err)
+		log.Logger = globalLogger
+	})
+
+	return cfg.File
+}
+
+// readComponentLogs returns json lines of the component from the log file
+func readComponentLogs(t *testing.T, path string, component string) []map[string]any {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var lines []map[string]any
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		if line["component"] == component {
+			lines = append(lines, line)
+		}
+	}
+	require.NoError(t, scanner.Err())
+
+	return lines
+}
+
+func TestSigningDecisionIsAudited(t *testing.T) {
+	logFile := initTestLogs(t)
+
+	apiKeyHash := sha256.Sum256([]byte("secret-api-key"))
+	authConfig := &config.AuthConfig{
+		ApiKeys: []string{"emulator:" + hex.EncodeToString(apiKeyHash[:])},
+	}
+	parsedAuthConfig, err := authConfig.Parse()
+	require.NoError(t, err)
+
+	cfg := newTestConfig()
+	cfg.AuthConfig = parsedAuthConfig
+	app := signerapp.NewSignerApp(nil, nil, nil, &config.ParsedSignerAppConfig{}, &chaincfg.RegressionNetParams)
+	server, err := signerservice.New(context.Background(), cfg, app, m.NewCovenantSignerMetrics())
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(server.Handler())
+	defer srv.Close()
+
+	client := signerservice.NewClient(srv.URL, &signerservice.ClientConfig{
+		RequestSigner: middlewares.NewApiKeyRequestSigner("secret-api-key"),
+	})
+
+	req, _ := newTestSigningRequest(t)
+	_, err = client.SignUnbonding(context.Background(), req)
+	require.Error(t, err)
+
+	audited := readComponentLogs(t, logFile, logging.ComponentAudit)
+	require.Len(t, audited, 1)
+	require.Equal(t, "emulator", audited[0]["client"])
+	require.Equal(t, "sign_unbonding", audited[0]["kind"])
+	require.Equal(t, "rejected", audited[0]["result"])
+	require.Equal(t, signerapp.CheckStakingOutputPkScript, audited[0]["reason"])
+	require.Equal(t, hex.EncodeToString(req.CovenantPublicKey.SerializeCompressed()), audited[0]["covenant_key"])
+	require.Equal(t, req.UnbondingTx.TxIn[0].PreviousOutPoint.String(), audited[0]["staking_outpoint"])
+}
@@ -0,0 +1,1475 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.3
+// source: covenantsigner/v1/signer.proto
+
+package signerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Phase2StakingData carries staking data of phase-2 staking transaction. If it
+// is not set, staking transaction is treated as phase-1 staking transaction.
+// Keys are 32 bytes BIP340 x-only public keys.
+type Phase2StakingData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StakerPublicKeyHex            string   `protobuf:"bytes,1,opt,name=staker_public_key_hex,json=stakerPublicKeyHex,proto3" json:"staker_public_key_hex,omitempty"`
+	FinalityProviderPublicKeysHex []string `protobuf:"bytes,2,rep,name=finality_provider_public_keys_hex,json=finalityProviderPublicKeysHex,proto3" json:"finality_provider_public_keys_hex,omitempty"`
+	StakingTime                   uint32   `protobuf:"varint,3,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
+}
+
+func (x *Phase2StakingData) Reset() {
+	*x = Phase2StakingData{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Phase2StakingData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Phase2StakingData) ProtoMessage() {}
+
+func (x *Phase2StakingData) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Phase2StakingData.ProtoReflect.Descriptor instead.
+func (*Phase2StakingData) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Phase2StakingData) GetStakerPublicKeyHex() string {
+	if x != nil {
+		return x.StakerPublicKeyHex
+	}
+	return ""
+}
+
+func (x *Phase2StakingData) GetFinalityProviderPublicKeysHex() []string {
+	if x != nil {
+		return x.FinalityProviderPublicKeysHex
+	}
+	return nil
+}
+
+func (x *Phase2StakingData) GetStakingTime() uint32 {
+	if x != nil {
+		return x.StakingTime
+	}
+	return 0
+}
+
+type SignUnbondingTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StakingOutputPkScriptHex string `protobuf:"bytes,1,opt,name=staking_output_pk_script_hex,json=stakingOutputPkScriptHex,proto3" json:"staking_output_pk_script_hex,omitempty"`
+	UnbondingTxHex           string `protobuf:"bytes,2,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
+	StakerUnbondingSigHex    string `protobuf:"bytes,3,opt,name=staker_unbonding_sig_hex,json=stakerUnbondingSigHex,proto3" json:"staker_unbonding_sig_hex,omitempty"`
+	// 33 bytes compressed public key
+	CovenantPublicKey string             `protobuf:"bytes,4,opt,name=covenant_public_key,json=covenantPublicKey,proto3" json:"covenant_public_key,omitempty"`
+	Phase2StakingData *Phase2StakingData `protobuf:"bytes,5,opt,name=phase2_staking_data,json=phase2StakingData,proto3" json:"phase2_staking_data,omitempty"`
+}
+
+func (x *SignUnbondingTxRequest) Reset() {
+	*x = SignUnbondingTxRequest{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUnbondingTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUnbondingTxRequest) ProtoMessage() {}
+
+func (x *SignUnbondingTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUnbondingTxRequest.ProtoReflect.Descriptor instead.
+func (*SignUnbondingTxRequest) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SignUnbondingTxRequest) GetStakingOutputPkScriptHex() string {
+	if x != nil {
+		return x.StakingOutputPkScriptHex
+	}
+	return ""
+}
+
+func (x *SignUnbondingTxRequest) GetUnbondingTxHex() string {
+	if x != nil {
+		return x.UnbondingTxHex
+	}
+	return ""
+}
+
+func (x *SignUnbondingTxRequest) GetStakerUnbondingSigHex() string {
+	if x != nil {
+		return x.StakerUnbondingSigHex
+	}
+	return ""
+}
+
+func (x *SignUnbondingTxRequest) GetCovenantPublicKey() string {
+	if x != nil {
+		return x.CovenantPublicKey
+	}
+	return ""
+}
+
+func (x *SignUnbondingTxRequest) GetPhase2StakingData() *Phase2StakingData {
+	if x != nil {
+		return x.Phase2StakingData
+	}
+	return nil
+}
+
+type SignUnbondingTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignatureHex string `protobuf:"bytes,1,opt,name=signature_hex,json=signatureHex,proto3" json:"signature_hex,omitempty"`
+}
+
+func (x *SignUnbondingTxResponse) Reset() {
+	*x = SignUnbondingTxResponse{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUnbondingTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUnbondingTxResponse) ProtoMessage() {}
+
+func (x *SignUnbondingTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUnbondingTxResponse.ProtoReflect.Descriptor instead.
+func (*SignUnbondingTxResponse) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SignUnbondingTxResponse) GetSignatureHex() string {
+	if x != nil {
+		return x.SignatureHex
+	}
+	return ""
+}
+
+type SignUnbondingTxsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*SignUnbondingTxRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *SignUnbondingTxsRequest) Reset() {
+	*x = SignUnbondingTxsRequest{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUnbondingTxsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUnbondingTxsRequest) ProtoMessage() {}
+
+func (x *SignUnbondingTxsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUnbondingTxsRequest.ProtoReflect.Descriptor instead.
+func (*SignUnbondingTxsRequest) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SignUnbondingTxsRequest) GetRequests() []*SignUnbondingTxRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// ItemError describes why single request from the batch failed
+type ItemError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode string `protobuf:"bytes,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ItemError) Reset() {
+	*x = ItemError{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemError) ProtoMessage() {}
+
+func (x *ItemError) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemError.ProtoReflect.Descriptor instead.
+func (*ItemError) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ItemError) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *ItemError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// SignUnbondingTxResult is result of single request from the batch. Exactly
+// one of the fields is set.
+type SignUnbondingTxResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignatureHex string     `protobuf:"bytes,1,opt,name=signature_hex,json=signatureHex,proto3" json:"signature_hex,omitempty"`
+	Error        *ItemError `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SignUnbondingTxResult) Reset() {
+	*x = SignUnbondingTxResult{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUnbondingTxResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUnbondingTxResult) ProtoMessage() {}
+
+func (x *SignUnbondingTxResult) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUnbondingTxResult.ProtoReflect.Descriptor instead.
+func (*SignUnbondingTxResult) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SignUnbondingTxResult) GetSignatureHex() string {
+	if x != nil {
+		return x.SignatureHex
+	}
+	return ""
+}
+
+func (x *SignUnbondingTxResult) GetError() *ItemError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// SignUnbondingTxsResponse results in the same order as requests in the batch
+type SignUnbondingTxsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*SignUnbondingTxResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SignUnbondingTxsResponse) Reset() {
+	*x = SignUnbondingTxsResponse{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUnbondingTxsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUnbondingTxsResponse) ProtoMessage() {}
+
+func (x *SignUnbondingTxsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUnbondingTxsResponse.ProtoReflect.Descriptor instead.
+func (*SignUnbondingTxsResponse) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SignUnbondingTxsResponse) GetResults() []*SignUnbondingTxResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SignUnbondingByStakingTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StakingTxHashHex      string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	StakingOutputIndex    uint32 `protobuf:"varint,2,opt,name=staking_output_index,json=stakingOutputIndex,proto3" json:"staking_output_index,omitempty"`
+	StakerUnbondingSigHex string `protobuf:"bytes,3,opt,name=staker_unbonding_sig_hex,json=stakerUnbondingSigHex,proto3" json:"staker_unbonding_sig_hex,omitempty"`
+	// 33 bytes compressed public key
+	CovenantPublicKey string `protobuf:"bytes,4,opt,name=covenant_public_key,json=covenantPublicKey,proto3" json:"covenant_public_key,omitempty"`
+	// optional, if set it must be equal to unbonding transaction built by the
+	// signer
+	UnbondingTxHex    string             `protobuf:"bytes,5,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
+	Phase2StakingData *Phase2StakingData `protobuf:"bytes,6,opt,name=phase2_staking_data,json=phase2StakingData,proto3" json:"phase2_staking_data,omitempty"`
+}
+
+func (x *SignUnbondingByStakingTxRequest) Reset() {
+	*x = SignUnbondingByStakingTxRequest{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUnbondingByStakingTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUnbondingByStakingTxRequest) ProtoMessage() {}
+
+func (x *SignUnbondingByStakingTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUnbondingByStakingTxRequest.ProtoReflect.Descriptor instead.
+func (*SignUnbondingByStakingTxRequest) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SignUnbondingByStakingTxRequest) GetStakingTxHashHex() string {
+	if x != nil {
+		return x.StakingTxHashHex
+	}
+	return ""
+}
+
+func (x *SignUnbondingByStakingTxRequest) GetStakingOutputIndex() uint32 {
+	if x != nil {
+		return x.StakingOutputIndex
+	}
+	return 0
+}
+
+func (x *SignUnbondingByStakingTxRequest) GetStakerUnbondingSigHex() string {
+	if x != nil {
+		return x.StakerUnbondingSigHex
+	}
+	return ""
+}
+
+func (x *SignUnbondingByStakingTxRequest) GetCovenantPublicKey() string {
+	if x != nil {
+		return x.CovenantPublicKey
+	}
+	return ""
+}
+
+func (x *SignUnbondingByStakingTxRequest) GetUnbondingTxHex() string {
+	if x != nil {
+		return x.UnbondingTxHex
+	}
+	return ""
+}
+
+func (x *SignUnbondingByStakingTxRequest) GetPhase2StakingData() *Phase2StakingData {
+	if x != nil {
+		return x.Phase2StakingData
+	}
+	return nil
+}
+
+type SignUnbondingByStakingTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnbondingTxHex string `protobuf:"bytes,1,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
+	SignatureHex   string `protobuf:"bytes,2,opt,name=signature_hex,json=signatureHex,proto3" json:"signature_hex,omitempty"`
+}
+
+func (x *SignUnbondingByStakingTxResponse) Reset() {
+	*x = SignUnbondingByStakingTxResponse{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUnbondingByStakingTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUnbondingByStakingTxResponse) ProtoMessage() {}
+
+func (x *SignUnbondingByStakingTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUnbondingByStakingTxResponse.ProtoReflect.Descriptor instead.
+func (*SignUnbondingByStakingTxResponse) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SignUnbondingByStakingTxResponse) GetUnbondingTxHex() string {
+	if x != nil {
+		return x.UnbondingTxHex
+	}
+	return ""
+}
+
+func (x *SignUnbondingByStakingTxResponse) GetSignatureHex() string {
+	if x != nil {
+		return x.SignatureHex
+	}
+	return ""
+}
+
+type SignUnbondingPsbtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PsbtBase64 string `protobuf:"bytes,1,opt,name=psbt_base64,json=psbtBase64,proto3" json:"psbt_base64,omitempty"`
+	// 33 bytes compressed public key
+	CovenantPublicKey string             `protobuf:"bytes,2,opt,name=covenant_public_key,json=covenantPublicKey,proto3" json:"covenant_public_key,omitempty"`
+	Phase2StakingData *Phase2StakingData `protobuf:"bytes,3,opt,name=phase2_staking_data,json=phase2StakingData,proto3" json:"phase2_staking_data,omitempty"`
+}
+
+func (x *SignUnbondingPsbtRequest) Reset() {
+	*x = SignUnbondingPsbtRequest{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUnbondingPsbtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUnbondingPsbtRequest) ProtoMessage() {}
+
+func (x *SignUnbondingPsbtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUnbondingPsbtRequest.ProtoReflect.Descriptor instead.
+func (*SignUnbondingPsbtRequest) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SignUnbondingPsbtRequest) GetPsbtBase64() string {
+	if x != nil {
+		return x.PsbtBase64
+	}
+	return ""
+}
+
+func (x *SignUnbondingPsbtRequest) GetCovenantPublicKey() string {
+	if x != nil {
+		return x.CovenantPublicKey
+	}
+	return ""
+}
+
+func (x *SignUnbondingPsbtRequest) GetPhase2StakingData() *Phase2StakingData {
+	if x != nil {
+		return x.Phase2StakingData
+	}
+	return nil
+}
+
+type SignUnbondingPsbtResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PsbtBase64 string `protobuf:"bytes,1,opt,name=psbt_base64,json=psbtBase64,proto3" json:"psbt_base64,omitempty"`
+}
+
+func (x *SignUnbondingPsbtResponse) Reset() {
+	*x = SignUnbondingPsbtResponse{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUnbondingPsbtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUnbondingPsbtResponse) ProtoMessage() {}
+
+func (x *SignUnbondingPsbtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUnbondingPsbtResponse.ProtoReflect.Descriptor instead.
+func (*SignUnbondingPsbtResponse) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SignUnbondingPsbtResponse) GetPsbtBase64() string {
+	if x != nil {
+		return x.PsbtBase64
+	}
+	return ""
+}
+
+type SignSlashingTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StakingOutputPkScriptHex string `protobuf:"bytes,1,opt,name=staking_output_pk_script_hex,json=stakingOutputPkScriptHex,proto3" json:"staking_output_pk_script_hex,omitempty"`
+	SlashingTxHex            string `protobuf:"bytes,2,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// 33 bytes compressed public key
+	CovenantPublicKey string             `protobuf:"bytes,3,opt,name=covenant_public_key,json=covenantPublicKey,proto3" json:"covenant_public_key,omitempty"`
+	Phase2StakingData *Phase2StakingData `protobuf:"bytes,4,opt,name=phase2_staking_data,json=phase2StakingData,proto3" json:"phase2_staking_data,omitempty"`
+}
+
+func (x *SignSlashingTxRequest) Reset() {
+	*x = SignSlashingTxRequest{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignSlashingTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignSlashingTxRequest) ProtoMessage() {}
+
+func (x *SignSlashingTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignSlashingTxRequest.ProtoReflect.Descriptor instead.
+func (*SignSlashingTxRequest) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SignSlashingTxRequest) GetStakingOutputPkScriptHex() string {
+	if x != nil {
+		return x.StakingOutputPkScriptHex
+	}
+	return ""
+}
+
+func (x *SignSlashingTxRequest) GetSlashingTxHex() string {
+	if x != nil {
+		return x.SlashingTxHex
+	}
+	return ""
+}
+
+func (x *SignSlashingTxRequest) GetCovenantPublicKey() string {
+	if x != nil {
+		return x.CovenantPublicKey
+	}
+	return ""
+}
+
+func (x *SignSlashingTxRequest) GetPhase2StakingData() *Phase2StakingData {
+	if x != nil {
+		return x.Phase2StakingData
+	}
+	return nil
+}
+
+type FinalityProviderAdaptorSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FinalityProviderPublicKeyHex string `protobuf:"bytes,1,opt,name=finality_provider_public_key_hex,json=finalityProviderPublicKeyHex,proto3" json:"finality_provider_public_key_hex,omitempty"`
+	AdaptorSignatureHex          string `protobuf:"bytes,2,opt,name=adaptor_signature_hex,json=adaptorSignatureHex,proto3" json:"adaptor_signature_hex,omitempty"`
+}
+
+func (x *FinalityProviderAdaptorSignature) Reset() {
+	*x = FinalityProviderAdaptorSignature{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalityProviderAdaptorSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalityProviderAdaptorSignature) ProtoMessage() {}
+
+func (x *FinalityProviderAdaptorSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalityProviderAdaptorSignature.ProtoReflect.Descriptor instead.
+func (*FinalityProviderAdaptorSignature) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FinalityProviderAdaptorSignature) GetFinalityProviderPublicKeyHex() string {
+	if x != nil {
+		return x.FinalityProviderPublicKeyHex
+	}
+	return ""
+}
+
+func (x *FinalityProviderAdaptorSignature) GetAdaptorSignatureHex() string {
+	if x != nil {
+		return x.AdaptorSignatureHex
+	}
+	return ""
+}
+
+type SignSlashingTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdaptorSignatures []*FinalityProviderAdaptorSignature `protobuf:"bytes,1,rep,name=adaptor_signatures,json=adaptorSignatures,proto3" json:"adaptor_signatures,omitempty"`
+}
+
+func (x *SignSlashingTxResponse) Reset() {
+	*x = SignSlashingTxResponse{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignSlashingTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignSlashingTxResponse) ProtoMessage() {}
+
+func (x *SignSlashingTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignSlashingTxResponse.ProtoReflect.Descriptor instead.
+func (*SignSlashingTxResponse) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SignSlashingTxResponse) GetAdaptorSignatures() []*FinalityProviderAdaptorSignature {
+	if x != nil {
+		return x.AdaptorSignatures
+	}
+	return nil
+}
+
+type SignUnbondingSlashingTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StakingOutputPkScriptHex string `protobuf:"bytes,1,opt,name=staking_output_pk_script_hex,json=stakingOutputPkScriptHex,proto3" json:"staking_output_pk_script_hex,omitempty"`
+	UnbondingTxHex           string `protobuf:"bytes,2,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
+	SlashingTxHex            string `protobuf:"bytes,3,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// 33 bytes compressed public key
+	CovenantPublicKey string             `protobuf:"bytes,4,opt,name=covenant_public_key,json=covenantPublicKey,proto3" json:"covenant_public_key,omitempty"`
+	Phase2StakingData *Phase2StakingData `protobuf:"bytes,5,opt,name=phase2_staking_data,json=phase2StakingData,proto3" json:"phase2_staking_data,omitempty"`
+}
+
+func (x *SignUnbondingSlashingTxRequest) Reset() {
+	*x = SignUnbondingSlashingTxRequest{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUnbondingSlashingTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUnbondingSlashingTxRequest) ProtoMessage() {}
+
+func (x *SignUnbondingSlashingTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUnbondingSlashingTxRequest.ProtoReflect.Descriptor instead.
+func (*SignUnbondingSlashingTxRequest) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SignUnbondingSlashingTxRequest) GetStakingOutputPkScriptHex() string {
+	if x != nil {
+		return x.StakingOutputPkScriptHex
+	}
+	return ""
+}
+
+func (x *SignUnbondingSlashingTxRequest) GetUnbondingTxHex() string {
+	if x != nil {
+		return x.UnbondingTxHex
+	}
+	return ""
+}
+
+func (x *SignUnbondingSlashingTxRequest) GetSlashingTxHex() string {
+	if x != nil {
+		return x.SlashingTxHex
+	}
+	return ""
+}
+
+func (x *SignUnbondingSlashingTxRequest) GetCovenantPublicKey() string {
+	if x != nil {
+		return x.CovenantPublicKey
+	}
+	return ""
+}
+
+func (x *SignUnbondingSlashingTxRequest) GetPhase2StakingData() *Phase2StakingData {
+	if x != nil {
+		return x.Phase2StakingData
+	}
+	return nil
+}
+
+type SignUnbondingSlashingTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdaptorSignatures []*FinalityProviderAdaptorSignature `protobuf:"bytes,1,rep,name=adaptor_signatures,json=adaptorSignatures,proto3" json:"adaptor_signatures,omitempty"`
+}
+
+func (x *SignUnbondingSlashingTxResponse) Reset() {
+	*x = SignUnbondingSlashingTxResponse{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUnbondingSlashingTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUnbondingSlashingTxResponse) ProtoMessage() {}
+
+func (x *SignUnbondingSlashingTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUnbondingSlashingTxResponse.ProtoReflect.Descriptor instead.
+func (*SignUnbondingSlashingTxResponse) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SignUnbondingSlashingTxResponse) GetAdaptorSignatures() []*FinalityProviderAdaptorSignature {
+	if x != nil {
+		return x.AdaptorSignatures
+	}
+	return nil
+}
+
+type ValidationCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed bool   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Error  string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ValidationCheck) Reset() {
+	*x = ValidationCheck{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationCheck) ProtoMessage() {}
+
+func (x *ValidationCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationCheck.ProtoReflect.Descriptor instead.
+func (*ValidationCheck) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ValidationCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ValidationCheck) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *ValidationCheck) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ValidateUnbondingTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid                         bool               `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	StakerPublicKeyHex            string             `protobuf:"bytes,2,opt,name=staker_public_key_hex,json=stakerPublicKeyHex,proto3" json:"staker_public_key_hex,omitempty"`
+	FinalityProviderPublicKeysHex []string           `protobuf:"bytes,3,rep,name=finality_provider_public_keys_hex,json=finalityProviderPublicKeysHex,proto3" json:"finality_provider_public_keys_hex,omitempty"`
+	StakingTime                   uint32             `protobuf:"varint,4,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
+	StakingAmountSat              int64              `protobuf:"varint,5,opt,name=staking_amount_sat,json=stakingAmountSat,proto3" json:"staking_amount_sat,omitempty"`
+	StakingTxInclusionHeight      uint32             `protobuf:"varint,6,opt,name=staking_tx_inclusion_height,json=stakingTxInclusionHeight,proto3" json:"staking_tx_inclusion_height,omitempty"`
+	ParamsVersion                 uint64             `protobuf:"varint,7,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	Confirmations                 int64              `protobuf:"varint,8,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Checks                        []*ValidationCheck `protobuf:"bytes,9,rep,name=checks,proto3" json:"checks,omitempty"`
+}
+
+func (x *ValidateUnbondingTxResponse) Reset() {
+	*x = ValidateUnbondingTxResponse{}
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateUnbondingTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateUnbondingTxResponse) ProtoMessage() {}
+
+func (x *ValidateUnbondingTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_covenantsigner_v1_signer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateUnbondingTxResponse.ProtoReflect.Descriptor instead.
+func (*ValidateUnbondingTxResponse) Descriptor() ([]byte, []int) {
+	return file_covenantsigner_v1_signer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ValidateUnbondingTxResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateUnbondingTxResponse) GetStakerPublicKeyHex() string {
+	if x != nil {
+		return x.StakerPublicKeyHex
+	}
+	return ""
+}
+
+func (x *ValidateUnbondingTxResponse) GetFinalityProviderPublicKeysHex() []string {
+	if x != nil {
+		return x.FinalityProviderPublicKeysHex
+	}
+	return nil
+}
+
+func (x *ValidateUnbondingTxResponse) GetStakingTime() uint32 {
+	if x != nil {
+		return x.StakingTime
+	}
+	return 0
+}
+
+func (x *ValidateUnbondingTxResponse) GetStakingAmountSat() int64 {
+	if x != nil {
+		return x.StakingAmountSat
+	}
+	return 0
+}
+
+func (x *ValidateUnbondingTxResponse) GetStakingTxInclusionHeight() uint32 {
+	if x != nil {
+		return x.StakingTxInclusionHeight
+	}
+	return 0
+}
+
+func (x *ValidateUnbondingTxResponse) GetParamsVersion() uint64 {
+	if x != nil {
+		return x.ParamsVersion
+	}
+	return 0
+}
+
+func (x *ValidateUnbondingTxResponse) GetConfirmations() int64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *ValidateUnbondingTxResponse) GetChecks() []*ValidationCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+var File_covenantsigner_v1_signer_proto protoreflect.FileDescriptor
+
+var file_covenantsigner_v1_signer_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x11, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x22, 0xb3, 0x01, 0x0a, 0x11, 0x50, 0x68, 0x61, 0x73, 0x65, 0x32, 0x53, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x31, 0x0a, 0x15, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x68,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x48, 0x65, 0x78, 0x12, 0x48, 0x0a, 0x21,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x68, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1d, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x73, 0x48, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xc1, 0x02, 0x0a, 0x16, 0x53, 0x69,
+	0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x1c, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x6b, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x73, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x6b, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x48, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x48, 0x65, 0x78, 0x12, 0x37,
+	0x0a, 0x18, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x69, 0x67, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x15, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x69, 0x67, 0x48, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x13, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x32, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x32, 0x53,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x11, 0x70, 0x68, 0x61, 0x73,
+	0x65, 0x32, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3e, 0x0a,
+	0x17, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x65, 0x78, 0x22, 0x60, 0x0a,
+	0x17, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x76,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22,
+	0x44, 0x0a, 0x09, 0x49, 0x74, 0x65, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x70, 0x0a, 0x15, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62,
+	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x68, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x48, 0x65, 0x78, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5e, 0x0a, 0x18, 0x53, 0x69, 0x67, 0x6e, 0x55,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62,
+	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xeb, 0x02, 0x0a, 0x1f, 0x53, 0x69, 0x67, 0x6e,
+	0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x53, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x68,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x48, 0x65, 0x78, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x37, 0x0a, 0x18,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x69, 0x67, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x69, 0x67, 0x48, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x48, 0x65, 0x78, 0x12,
+	0x54, 0x0a, 0x13, 0x70, 0x68, 0x61, 0x73, 0x65, 0x32, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63,
+	0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x32, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x11, 0x70, 0x68, 0x61, 0x73, 0x65, 0x32, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0x71, 0x0a, 0x20, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62,
+	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x6e, 0x62,
+	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78,
+	0x48, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x68, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x65, 0x78, 0x22, 0xc1, 0x01, 0x0a, 0x18, 0x53, 0x69, 0x67,
+	0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x73, 0x62, 0x74, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x36, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x73, 0x62, 0x74,
+	0x42, 0x61, 0x73, 0x65, 0x36, 0x34, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x13, 0x70, 0x68, 0x61, 0x73, 0x65, 0x32,
+	0x5f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x32, 0x53, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x11, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x32, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3c, 0x0a, 0x19,
+	0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x73, 0x62,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x73, 0x62,
+	0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x73, 0x62, 0x74, 0x42, 0x61, 0x73, 0x65, 0x36, 0x34, 0x22, 0x85, 0x02, 0x0a, 0x15, 0x53,
+	0x69, 0x67, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x1c, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x6b, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x73, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x6b, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x48, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x48, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x13,
+	0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x13,
+	0x70, 0x68, 0x61, 0x73, 0x65, 0x32, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x32, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x11, 0x70, 0x68, 0x61, 0x73, 0x65, 0x32, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0x9e, 0x01, 0x0a, 0x20, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x64, 0x61, 0x70, 0x74, 0x6f, 0x72, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x46, 0x0a, 0x20, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x1c, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x48, 0x65, 0x78, 0x12,
+	0x32, 0x0a, 0x15, 0x61, 0x64, 0x61, 0x70, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x48, 0x65, 0x78, 0x22, 0x7c, 0x0a, 0x16, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
+	0x12, 0x61, 0x64, 0x61, 0x70, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x64,
+	0x61, 0x70, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x11,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x22, 0xb8, 0x02, 0x0a, 0x1e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x1c, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x6b, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x5f, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x73, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x6b, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x48, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x48, 0x65, 0x78, 0x12, 0x26,
+	0x0a, 0x0f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x65,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x54, 0x78, 0x48, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x13, 0x70, 0x68, 0x61, 0x73, 0x65, 0x32,
+	0x5f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x32, 0x53, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x11, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x32, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0x85, 0x01, 0x0a,
+	0x1f, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x62, 0x0a, 0x12, 0x61, 0x64, 0x61, 0x70, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63,
+	0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x41, 0x64, 0x61, 0x70, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x11, 0x61, 0x64, 0x61, 0x70, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73,
+	0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc9, 0x03, 0x0a, 0x1b, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12,
+	0x31, 0x0a, 0x15, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x48,
+	0x65, 0x78, 0x12, 0x48, 0x0a, 0x21, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
+	0x65, 0x79, 0x73, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1d, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x48, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x12, 0x3d, 0x0a,
+	0x1b, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x18, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x49, 0x6e, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x32, 0xb1, 0x06, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x12, 0x68, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x54, 0x78, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x10, 0x53, 0x69,
+	0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x73, 0x12, 0x2a,
+	0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x76,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x18, 0x53, 0x69, 0x67, 0x6e,
+	0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x53, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x54, 0x78, 0x12, 0x32, 0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62,
+	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54,
+	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x53, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a,
+	0x11, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x73,
+	0x62, 0x74, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a,
+	0x0e, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x12,
+	0x28, 0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x17, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62,
+	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x78,
+	0x12, 0x31, 0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x12, 0x29,
+	0x2e, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x62, 0x79, 0x6c, 0x6f, 0x6e, 0x6c,
+	0x61, 0x62, 0x73, 0x2d, 0x69, 0x6f, 0x2f, 0x63, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2d,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x3b, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_covenantsigner_v1_signer_proto_rawDescOnce sync.Once
+	file_covenantsigner_v1_signer_proto_rawDescData = file_covenantsigner_v1_signer_proto_rawDesc
+)
+
+func file_covenantsigner_v1_signer_proto_rawDescGZIP() []byte {
+	file_covenantsigner_v1_signer_proto_rawDescOnce.Do(func() {
+		file_covenantsigner_v1_signer_proto_rawDescData = protoimpl.X.CompressGZIP(file_covenantsigner_v1_signer_proto_rawDescData)
+	})
+	return file_covenantsigner_v1_signer_proto_rawDescData
+}
+
+var file_covenantsigner_v1_signer_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_covenantsigner_v1_signer_proto_goTypes = []any{
+	(*Phase2StakingData)(nil),                // 0: covenantsigner.v1.Phase2StakingData
+	(*SignUnbondingTxRequest)(nil),           // 1: covenantsigner.v1.SignUnbondingTxRequest
+	(*SignUnbondingTxResponse)(nil),          // 2: covenantsigner.v1.SignUnbondingTxResponse
+	(*SignUnbondingTxsRequest)(nil),          // 3: covenantsigner.v1.SignUnbondingTxsRequest
+	(*ItemError)(nil),                        // 4: covenantsigner.v1.ItemError
+	(*SignUnbondingTxResult)(nil),            // 5: covenantsigner.v1.SignUnbondingTxResult
+	(*SignUnbondingTxsResponse)(nil),         // 6: covenantsigner.v1.SignUnbondingTxsResponse
+	(*SignUnbondingByStakingTxRequest)(nil),  // 7: covenantsigner.v1.SignUnbondingByStakingTxRequest
+	(*SignUnbondingByStakingTxResponse)(nil), // 8: covenantsigner.v1.SignUnbondingByStakingTxResponse
+	(*SignUnbondingPsbtRequest)(nil),         // 9: covenantsigner.v1.SignUnbondingPsbtRequest
+	(*SignUnbondingPsbtResponse)(nil),        // 10: covenantsigner.v1.SignUnbondingPsbtResponse
+	(*SignSlashingTxRequest)(nil),            // 11: covenantsigner.v1.SignSlashingTxRequest
+	(*FinalityProviderAdaptorSignature)(nil), // 12: covenantsigner.v1.FinalityProviderAdaptorSignature
+	(*SignSlashingTxResponse)(nil),           // 13: covenantsigner.v1.SignSlashingTxResponse
+	(*SignUnbondingSlashingTxRequest)(nil),   // 14: covenantsigner.v1.SignUnbondingSlashingTxRequest
+	(*SignUnbondingSlashingTxResponse)(nil),  // 15: covenantsigner.v1.SignUnbondingSlashingTxResponse
+	(*ValidationCheck)(nil),                  // 16: covenantsigner.v1.ValidationCheck
+	(*ValidateUnbondingTxResponse)(nil),      // 17: covenantsigner.v1.ValidateUnbondingTxResponse
+}
+var file_covenantsigner_v1_signer_proto_depIdxs = []int32{
+	0,  // 0: covenantsigner.v1.SignUnbondingTxRequest.phase2_staking_data:type_name -> covenantsigner.v1.Phase2StakingData
+	1,  // 1: covenantsigner.v1.SignUnbondingTxsRequest.requests:type_name -> covenantsigner.v1.SignUnbondingTxRequest
+	4,  // 2: covenantsigner.v1.SignUnbondingTxResult.error:type_name -> covenantsigner.v1.ItemError
+	5,  // 3: covenantsigner.v1.SignUnbondingTxsResponse.results:type_name -> covenantsigner.v1.SignUnbondingTxResult
+	0,  // 4: covenantsigner.v1.SignUnbondingByStakingTxRequest.phase2_staking_data:type_name -> covenantsigner.v1.Phase2StakingData
+	0,  // 5: covenantsigner.v1.SignUnbondingPsbtRequest.phase2_staking_data:type_name -> covenantsigner.v1.Phase2StakingData
+	0,  // 6: covenantsigner.v1.SignSlashingTxRequest.phase2_staking_data:type_name -> covenantsigner.v1.Phase2StakingData
+	12, // 7: covenantsigner.v1.SignSlashingTxResponse.adaptor_signatures:type_name -> covenantsigner.v1.FinalityProviderAdaptorSignature
+	0,  // 8: covenantsigner.v1.SignUnbondingSlashingTxRequest.phase2_staking_data:type_name -> covenantsigner.v1.Phase2StakingData
+	12, // 9: covenantsigner.v1.SignUnbondingSlashingTxResponse.adaptor_signatures:type_name -> covenantsigner.v1.FinalityProviderAdaptorSignature
+	16, // 10: covenantsigner.v1.ValidateUnbondingTxResponse.checks:type_name -> covenantsigner.v1.ValidationCheck
+	1,  // 11: covenantsigner.v1.Signer.SignUnbondingTx:input_type -> covenantsigner.v1.SignUnbondingTxRequest
+	3,  // 12: covenantsigner.v1.Signer.SignUnbondingTxs:input_type -> covenantsigner.v1.SignUnbondingTxsRequest
+	7,  // 13: covenantsigner.v1.Signer.SignUnbondingByStakingTx:input_type -> covenantsigner.v1.SignUnbondingByStakingTxRequest
+	9,  // 14: covenantsigner.v1.Signer.SignUnbondingPsbt:input_type -> covenantsigner.v1.SignUnbondingPsbtRequest
+	11, // 15: covenantsigner.v1.Signer.SignSlashingTx:input_type -> covenantsigner.v1.SignSlashingTxRequest
+	14, // 16: covenantsigner.v1.Signer.SignUnbondingSlashingTx:input_type -> covenantsigner.v1.SignUnbondingSlashingTxRequest
+	1,  // 17: covenantsigner.v1.Signer.ValidateUnbondingTx:input_type -> covenantsigner.v1.SignUnbondingTxRequest
+	2,  // 18: covenantsigner.v1.Signer.SignUnbondingTx:output_type -> covenantsigner.v1.SignUnbondingTxResponse
+	6,  // 19: covenantsigner.v1.Signer.SignUnbondingTxs:output_type -> covenantsigner.v1.SignUnbondingTxsResponse
+	8,  // 20: covenantsigner.v1.Signer.SignUnbondingByStakingTx:output_type -> covenantsigner.v1.SignUnbondingByStakingTxResponse
+	10, // 21: covenantsigner.v1.Signer.SignUnbondingPsbt:output_type -> covenantsigner.v1.SignUnbondingPsbtResponse
+	13, // 22: covenantsigner.v1.Signer.SignSlashingTx:output_type -> covenantsigner.v1.SignSlashingTxResponse
+	15, // 23: covenantsigner.v1.Signer.SignUnbondingSlashingTx:output_type -> covenantsigner.v1.SignUnbondingSlashingTxResponse
+	17, // 24: covenantsigner.v1.Signer.ValidateUnbondingTx:output_type -> covenantsigner.v1.ValidateUnbondingTxResponse
+	18, // [18:25] is the sub-list for method output_type
+	11, // [11:18] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_covenantsigner_v1_signer_proto_init() }
+func file_covenantsigner_v1_signer_proto_init() {
+	if File_covenantsigner_v1_signer_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_covenantsigner_v1_signer_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_covenantsigner_v1_signer_proto_goTypes,
+		DependencyIndexes: file_covenantsigner_v1_signer_proto_depIdxs,
+		MessageInfos:      file_covenantsigner_v1_signer_proto_msgTypes,
+	}.Build()
+	File_covenantsigner_v1_signer_proto = out.File
+	file_covenantsigner_v1_signer_proto_rawDesc = nil
+	file_covenantsigner_v1_signer_proto_goTypes = nil
+	file_covenantsigner_v1_signer_proto_depIdxs = nil
+}
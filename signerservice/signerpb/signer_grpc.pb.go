@@ -0,0 +1,357 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: covenantsigner/v1/signer.proto
+
+package signerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Signer_SignUnbondingTx_FullMethodName          = "/covenantsigner.v1.Signer/SignUnbondingTx"
+	Signer_SignUnbondingTxs_FullMethodName         = "/covenantsigner.v1.Signer/SignUnbondingTxs"
+	Signer_SignUnbondingByStakingTx_FullMethodName = "/covenantsigner.v1.Signer/SignUnbondingByStakingTx"
+	Signer_SignUnbondingPsbt_FullMethodName        = "/covenantsigner.v1.Signer/SignUnbondingPsbt"
+	Signer_SignSlashingTx_FullMethodName           = "/covenantsigner.v1.Signer/SignSlashingTx"
+	Signer_SignUnbondingSlashingTx_FullMethodName  = "/covenantsigner.v1.Signer/SignUnbondingSlashingTx"
+	Signer_ValidateUnbondingTx_FullMethodName      = "/covenantsigner.v1.Signer/ValidateUnbondingTx"
+)
+
+// SignerClient is the client API for Signer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Signer exposes the same operations as HTTP API of the signing server.
+// Failed requests return gRPC status with google.rpc.ErrorInfo detail, its
+// reason is the error code returned by HTTP API e.g CHAIN_REORG.
+type SignerClient interface {
+	SignUnbondingTx(ctx context.Context, in *SignUnbondingTxRequest, opts ...grpc.CallOption) (*SignUnbondingTxResponse, error)
+	SignUnbondingTxs(ctx context.Context, in *SignUnbondingTxsRequest, opts ...grpc.CallOption) (*SignUnbondingTxsResponse, error)
+	SignUnbondingByStakingTx(ctx context.Context, in *SignUnbondingByStakingTxRequest, opts ...grpc.CallOption) (*SignUnbondingByStakingTxResponse, error)
+	SignUnbondingPsbt(ctx context.Context, in *SignUnbondingPsbtRequest, opts ...grpc.CallOption) (*SignUnbondingPsbtResponse, error)
+	SignSlashingTx(ctx context.Context, in *SignSlashingTxRequest, opts ...grpc.CallOption) (*SignSlashingTxResponse, error)
+	SignUnbondingSlashingTx(ctx context.Context, in *SignUnbondingSlashingTxRequest, opts ...grpc.CallOption) (*SignUnbondingSlashingTxResponse, error)
+	ValidateUnbondingTx(ctx context.Context, in *SignUnbondingTxRequest, opts ...grpc.CallOption) (*ValidateUnbondingTxResponse, error)
+}
+
+type signerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSignerClient(cc grpc.ClientConnInterface) SignerClient {
+	return &signerClient{cc}
+}
+
+func (c *signerClient) SignUnbondingTx(ctx context.Context, in *SignUnbondingTxRequest, opts ...grpc.CallOption) (*SignUnbondingTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignUnbondingTxResponse)
+	err := c.cc.Invoke(ctx, Signer_SignUnbondingTx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) SignUnbondingTxs(ctx context.Context, in *SignUnbondingTxsRequest, opts ...grpc.CallOption) (*SignUnbondingTxsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignUnbondingTxsResponse)
+	err := c.cc.Invoke(ctx, Signer_SignUnbondingTxs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) SignUnbondingByStakingTx(ctx context.Context, in *SignUnbondingByStakingTxRequest, opts ...grpc.CallOption) (*SignUnbondingByStakingTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignUnbondingByStakingTxResponse)
+	err := c.cc.Invoke(ctx, Signer_SignUnbondingByStakingTx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) SignUnbondingPsbt(ctx context.Context, in *SignUnbondingPsbtRequest, opts ...grpc.CallOption) (*SignUnbondingPsbtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignUnbondingPsbtResponse)
+	err := c.cc.Invoke(ctx, Signer_SignUnbondingPsbt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) SignSlashingTx(ctx context.Context, in *SignSlashingTxRequest, opts ...grpc.CallOption) (*SignSlashingTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignSlashingTxResponse)
+	err := c.cc.Invoke(ctx, Signer_SignSlashingTx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) SignUnbondingSlashingTx(ctx context.Context, in *SignUnbondingSlashingTxRequest, opts ...grpc.CallOption) (*SignUnbondingSlashingTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignUnbondingSlashingTxResponse)
+	err := c.cc.Invoke(ctx, Signer_SignUnbondingSlashingTx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) ValidateUnbondingTx(ctx context.Context, in *SignUnbondingTxRequest, opts ...grpc.CallOption) (*ValidateUnbondingTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateUnbondingTxResponse)
+	err := c.cc.Invoke(ctx, Signer_ValidateUnbondingTx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SignerServer is the server API for Signer service.
+// All implementations must embed UnimplementedSignerServer
+// for forward compatibility.
+//
+// Signer exposes the same operations as HTTP API of the signing server.
+// Failed requests return gRPC status with google.rpc.ErrorInfo detail, its
+// reason is the error code returned by HTTP API e.g CHAIN_REORG.
+type SignerServer interface {
+	SignUnbondingTx(context.Context, *SignUnbondingTxRequest) (*SignUnbondingTxResponse, error)
+	SignUnbondingTxs(context.Context, *SignUnbondingTxsRequest) (*SignUnbondingTxsResponse, error)
+	SignUnbondingByStakingTx(context.Context, *SignUnbondingByStakingTxRequest) (*SignUnbondingByStakingTxResponse, error)
+	SignUnbondingPsbt(context.Context, *SignUnbondingPsbtRequest) (*SignUnbondingPsbtResponse, error)
+	SignSlashingTx(context.Context, *SignSlashingTxRequest) (*SignSlashingTxResponse, error)
+	SignUnbondingSlashingTx(context.Context, *SignUnbondingSlashingTxRequest) (*SignUnbondingSlashingTxResponse, error)
+	ValidateUnbondingTx(context.Context, *SignUnbondingTxRequest) (*ValidateUnbondingTxResponse, error)
+	mustEmbedUnimplementedSignerServer()
+}
+
+// UnimplementedSignerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSignerServer struct{}
+
+func (UnimplementedSignerServer) SignUnbondingTx(context.Context, *SignUnbondingTxRequest) (*SignUnbondingTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignUnbondingTx not implemented")
+}
+func (UnimplementedSignerServer) SignUnbondingTxs(context.Context, *SignUnbondingTxsRequest) (*SignUnbondingTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignUnbondingTxs not implemented")
+}
+func (UnimplementedSignerServer) SignUnbondingByStakingTx(context.Context, *SignUnbondingByStakingTxRequest) (*SignUnbondingByStakingTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignUnbondingByStakingTx not implemented")
+}
+func (UnimplementedSignerServer) SignUnbondingPsbt(context.Context, *SignUnbondingPsbtRequest) (*SignUnbondingPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignUnbondingPsbt not implemented")
+}
+func (UnimplementedSignerServer) SignSlashingTx(context.Context, *SignSlashingTxRequest) (*SignSlashingTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignSlashingTx not implemented")
+}
+func (UnimplementedSignerServer) SignUnbondingSlashingTx(context.Context, *SignUnbondingSlashingTxRequest) (*SignUnbondingSlashingTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignUnbondingSlashingTx not implemented")
+}
+func (UnimplementedSignerServer) ValidateUnbondingTx(context.Context, *SignUnbondingTxRequest) (*ValidateUnbondingTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateUnbondingTx not implemented")
+}
+func (UnimplementedSignerServer) mustEmbedUnimplementedSignerServer() {}
+func (UnimplementedSignerServer) testEmbeddedByValue()                {}
+
+// UnsafeSignerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SignerServer will
+// result in compilation errors.
+type UnsafeSignerServer interface {
+	mustEmbedUnimplementedSignerServer()
+}
+
+func RegisterSignerServer(s grpc.ServiceRegistrar, srv SignerServer) {
+	// If the following call pancis, it indicates UnimplementedSignerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Signer_ServiceDesc, srv)
+}
+
+func _Signer_SignUnbondingTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUnbondingTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignUnbondingTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Signer_SignUnbondingTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignUnbondingTx(ctx, req.(*SignUnbondingTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_SignUnbondingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUnbondingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignUnbondingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Signer_SignUnbondingTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignUnbondingTxs(ctx, req.(*SignUnbondingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_SignUnbondingByStakingTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUnbondingByStakingTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignUnbondingByStakingTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Signer_SignUnbondingByStakingTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignUnbondingByStakingTx(ctx, req.(*SignUnbondingByStakingTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_SignUnbondingPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUnbondingPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignUnbondingPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Signer_SignUnbondingPsbt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignUnbondingPsbt(ctx, req.(*SignUnbondingPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_SignSlashingTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignSlashingTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignSlashingTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Signer_SignSlashingTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignSlashingTx(ctx, req.(*SignSlashingTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_SignUnbondingSlashingTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUnbondingSlashingTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignUnbondingSlashingTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Signer_SignUnbondingSlashingTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignUnbondingSlashingTx(ctx, req.(*SignUnbondingSlashingTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_ValidateUnbondingTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUnbondingTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).ValidateUnbondingTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Signer_ValidateUnbondingTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).ValidateUnbondingTx(ctx, req.(*SignUnbondingTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Signer_ServiceDesc is the grpc.ServiceDesc for Signer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Signer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "covenantsigner.v1.Signer",
+	HandlerType: (*SignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignUnbondingTx",
+			Handler:    _Signer_SignUnbondingTx_Handler,
+		},
+		{
+			MethodName: "SignUnbondingTxs",
+			Handler:    _Signer_SignUnbondingTxs_Handler,
+		},
+		{
+			MethodName: "SignUnbondingByStakingTx",
+			Handler:    _Signer_SignUnbondingByStakingTx_Handler,
+		},
+		{
+			MethodName: "SignUnbondingPsbt",
+			Handler:    _Signer_SignUnbondingPsbt_Handler,
+		},
+		{
+			MethodName: "SignSlashingTx",
+			Handler:    _Signer_SignSlashingTx_Handler,
+		},
+		{
+			MethodName: "SignUnbondingSlashingTx",
+			Handler:    _Signer_SignUnbondingSlashingTx_Handler,
+		},
+		{
+			MethodName: "ValidateUnbondingTx",
+			Handler:    _Signer_ValidateUnbondingTx_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "covenantsigner/v1/signer.proto",
+}
@@ -0,0 +1,48 @@
+package types
+
+// AdminParamsVersion is loaded version of global params
+type AdminParamsVersion struct {
+	Version          uint64 `json:"version"`
+	ActivationHeight uint64 `json:"activation_height"`
+}
+
+type AdminParamsResponse struct {
+	Versions []AdminParamsVersion `json:"versions"`
+}
+
+// AdminBackendStatus is result of connectivity check of signer backend.
+// Error is set only if the backend is down.
+type AdminBackendStatus struct {
+	Name      string `json:"name"`
+	Up        bool   `json:"up"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+type AdminStatusResponse struct {
+	Paused   bool                 `json:"paused"`
+	Frozen   bool                 `json:"frozen"`
+	Backends []AdminBackendStatus `json:"backends"`
+}
+
+type AdminPauseResponse struct {
+	Paused bool `json:"paused"`
+}
+
+type AdminFreezeResponse struct {
+	Frozen bool `json:"frozen"`
+}
+
+// AdminLogLevelRequest changes level of single component, or the default
+// level if component is empty
+type AdminLogLevelRequest struct {
+	Component string `json:"component"`
+	Level     string `json:"level"`
+}
+
+// AdminLogLevelResponse contains the default level and effective level of
+// each component
+type AdminLogLevelResponse struct {
+	Level      string            `json:"level"`
+	Components map[string]string `json:"components"`
+}
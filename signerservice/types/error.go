@@ -18,8 +18,41 @@ const (
 	NotFound             ErrorCode = "NOT_FOUND"
 	BadRequest           ErrorCode = "BAD_REQUEST"
 	Forbidden            ErrorCode = "FORBIDDEN"
+	Unauthorized         ErrorCode = "UNAUTHORIZED"
+	// Retryable errors, request may succeed if sent again later
+	ChainReorg      ErrorCode = "CHAIN_REORG"
+	TooManyRequests ErrorCode = "TOO_MANY_REQUESTS"
+	ServicePaused   ErrorCode = "SERVICE_PAUSED"
+	SigningFrozen   ErrorCode = "SIGNING_FROZEN"
 )
 
+// ErrorCodes returns all error codes which can be returned by the service
+func ErrorCodes() []ErrorCode {
+	return []ErrorCode{
+		InternalServiceError,
+		ValidationError,
+		NotFound,
+		BadRequest,
+		Forbidden,
+		Unauthorized,
+		ChainReorg,
+		TooManyRequests,
+		ServicePaused,
+		SigningFrozen,
+	}
+}
+
+// Retryable returns true if request failed with the error code may succeed
+// if sent again later
+func (e ErrorCode) Retryable() bool {
+	switch e {
+	case ChainReorg, TooManyRequests, ServicePaused, SigningFrozen:
+		return true
+	default:
+		return false
+	}
+}
+
 // Error represents an error with an HTTP status code and an application-specific error code.
 type Error struct {
 	Err        error
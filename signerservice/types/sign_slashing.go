@@ -0,0 +1,24 @@
+package types
+
+// SignSlashingTxRequest carries all data necessary to sign slashing transaction
+// spending staking output
+type SignSlashingTxRequest struct {
+	StakingOutputPkScriptHex string `json:"staking_output_pk_script_hex"`
+	SlashingTxHex            string `json:"slashing_tx_hex"`
+	// 33 bytes compressed public key
+	CovenantPublicKey string `json:"covenant_public_key"`
+	Phase2StakingData
+}
+
+// FinalityProviderAdaptorSignature covenant member adaptor signature encrypted
+// with finality provider public key
+type FinalityProviderAdaptorSignature struct {
+	FinalityProviderPublicKeyHex string `json:"finality_provider_public_key_hex"`
+	AdaptorSignatureHex          string `json:"adaptor_signature_hex"`
+}
+
+// SignSlashingTxResponse covenant member adaptor signatures, one for each
+// finality provider
+type SignSlashingTxResponse struct {
+	AdaptorSignatures []FinalityProviderAdaptorSignature `json:"adaptor_signatures"`
+}
@@ -1,5 +1,16 @@
 package types
 
+// Phase2StakingData carries staking data of phase-2 staking transaction.
+// Phase-2 staking transactions do not have OP_RETURN output, so staking data
+// must be provided in the request. If staker public key is not set, staking
+// transaction is treated as phase-1 staking transaction.
+// Keys are 32 bytes BIP340 x-only public keys
+type Phase2StakingData struct {
+	StakerPublicKeyHex            string   `json:"staker_public_key_hex,omitempty"`
+	FinalityProviderPublicKeysHex []string `json:"finality_provider_public_keys_hex,omitempty"`
+	StakingTime                   uint16   `json:"staking_time,omitempty"`
+}
+
 // SignUnbondingTxPayload carries all data necessary to sign unbonding transaction
 type SignUnbondingTxRequest struct {
 	StakingOutputPkScriptHex string `json:"staking_output_pk_script_hex"`
@@ -7,9 +18,38 @@ type SignUnbondingTxRequest struct {
 	StakerUnbondingSigHex    string `json:"staker_unbonding_sig_hex"`
 	// 33 bytes compressed public key
 	CovenantPublicKey string `json:"covenant_public_key"`
+	Phase2StakingData
 }
 
 // SignUnbondingTxResponse covenant member schnorr signature
 type SignUnbondingTxResponse struct {
 	SignatureHex string `json:"signature_hex"`
 }
+
+// SignUnbondingTxsRequest carries batch of independent unbonding signing requests
+type SignUnbondingTxsRequest struct {
+	Requests []SignUnbondingTxRequest `json:"requests"`
+}
+
+// ItemError describes why single request from the batch failed. Error codes
+// are the same as error codes of single request.
+type ItemError struct {
+	ErrorCode string `json:"errorCode"`
+	Message   string `json:"message"`
+}
+
+func (e *ItemError) Error() string {
+	return e.ErrorCode + ": " + e.Message
+}
+
+// SignUnbondingTxResult is result of single request from the batch. Exactly one
+// of the fields is set.
+type SignUnbondingTxResult struct {
+	SignatureHex string     `json:"signature_hex,omitempty"`
+	Error        *ItemError `json:"error,omitempty"`
+}
+
+// SignUnbondingTxsResponse results in the same order as requests in the batch
+type SignUnbondingTxsResponse struct {
+	Results []SignUnbondingTxResult `json:"results"`
+}
@@ -0,0 +1,22 @@
+package types
+
+// SignUnbondingByStakingTxRequest carries data necessary to sign unbonding
+// transaction of the staking output identified by staking tx hash and output
+// index. Unbonding transaction is built by the signer, if it is provided in
+// the request it must be equal to the built one.
+type SignUnbondingByStakingTxRequest struct {
+	StakingTxHashHex      string `json:"staking_tx_hash_hex"`
+	StakingOutputIndex    uint32 `json:"staking_output_index"`
+	StakerUnbondingSigHex string `json:"staker_unbonding_sig_hex"`
+	// 33 bytes compressed public key
+	CovenantPublicKey string `json:"covenant_public_key"`
+	UnbondingTxHex    string `json:"unbonding_tx_hex,omitempty"`
+	Phase2StakingData
+}
+
+// SignUnbondingByStakingTxResponse unbonding transaction built by the signer
+// with covenant member schnorr signature over it
+type SignUnbondingByStakingTxResponse struct {
+	UnbondingTxHex string `json:"unbonding_tx_hex"`
+	SignatureHex   string `json:"signature_hex"`
+}
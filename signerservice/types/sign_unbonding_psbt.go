@@ -0,0 +1,17 @@
+package types
+
+// SignUnbondingPsbtRequest carries base64 encoded PSBT packet with unbonding
+// transaction, staking output as witness utxo and staker taproot script spend
+// signature
+type SignUnbondingPsbtRequest struct {
+	PsbtBase64 string `json:"psbt_base64"`
+	// 33 bytes compressed public key
+	CovenantPublicKey string `json:"covenant_public_key"`
+	Phase2StakingData
+}
+
+// SignUnbondingPsbtResponse base64 encoded PSBT packet with staker and covenant
+// member taproot script spend signatures
+type SignUnbondingPsbtResponse struct {
+	PsbtBase64 string `json:"psbt_base64"`
+}
@@ -0,0 +1,18 @@
+package types
+
+// SignUnbondingSlashingTxRequest carries all data necessary to sign slashing
+// transaction spending unbonding output
+type SignUnbondingSlashingTxRequest struct {
+	StakingOutputPkScriptHex string `json:"staking_output_pk_script_hex"`
+	UnbondingTxHex           string `json:"unbonding_tx_hex"`
+	SlashingTxHex            string `json:"slashing_tx_hex"`
+	// 33 bytes compressed public key
+	CovenantPublicKey string `json:"covenant_public_key"`
+	Phase2StakingData
+}
+
+// SignUnbondingSlashingTxResponse covenant member adaptor signatures, one for
+// each finality provider
+type SignUnbondingSlashingTxResponse struct {
+	AdaptorSignatures []FinalityProviderAdaptorSignature `json:"adaptor_signatures"`
+}
@@ -0,0 +1,24 @@
+package types
+
+// ValidationCheck is result of single check done during request validation
+type ValidationCheck struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ValidateUnbondingTxResponse describes result of dry-run validation of
+// unbonding signing request. Staking data is set only if staking transaction
+// was successfully retrieved and parsed. Keys are 32 bytes BIP340 x-only
+// public keys.
+type ValidateUnbondingTxResponse struct {
+	Valid                         bool              `json:"valid"`
+	StakerPublicKeyHex            string            `json:"staker_public_key_hex,omitempty"`
+	FinalityProviderPublicKeysHex []string          `json:"finality_provider_public_keys_hex,omitempty"`
+	StakingTime                   uint16            `json:"staking_time,omitempty"`
+	StakingAmountSat              int64             `json:"staking_amount_sat,omitempty"`
+	StakingTxInclusionHeight      uint32            `json:"staking_tx_inclusion_height,omitempty"`
+	ParamsVersion                 uint64            `json:"params_version"`
+	Confirmations                 int64             `json:"confirmations"`
+	Checks                        []ValidationCheck `json:"checks"`
+}